@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/shiqi/datai/backend/internal/config"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+const devTokenUsage = `用法: datai dev-token --sub=<用户 ID> [--tenant=<租户 ID>] [--ttl=24h]
+
+用本地开发密钥（auth.dev.key_file）签发 token，服务需要以 AUTH_DEV=true 启动才会信任它：
+  curl -H "Authorization: Bearer $(go run . dev-token --sub=alice)" http://localhost:8080/query`
+
+// runDevTokenCommand 执行 dev-token 子命令，把 token 输出到标准输出，返回进程退出码
+func runDevTokenCommand(cfg *config.Config, args []string) int {
+	fs := flag.NewFlagSet("dev-token", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprintln(os.Stderr, devTokenUsage) }
+	sub := fs.String("sub", "", "token 的 sub，即用户 ID")
+	tenant := fs.String("tenant", "", "写入 token 的租户 ID")
+	ttl := fs.Duration("ttl", 24*time.Hour, "有效期")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *sub == "" || fs.NArg() > 0 || *ttl <= 0 {
+		fs.Usage()
+		return 2
+	}
+
+	devAuth, err := middleware.LoadDevAuth(cfg.Auth.Dev.KeyFile, cfg.Auth.Dev.Issuer)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "dev-token:", err)
+		return 1
+	}
+	token, err := devAuth.Mint(*sub, *tenant, *ttl)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "dev-token:", err)
+		return 1
+	}
+	if !cfg.Auth.Dev.Enabled {
+		fmt.Fprintln(os.Stderr, "warning: dev auth is disabled, start the server with AUTH_DEV=true to accept this token")
+	}
+	fmt.Println(token)
+	return 0
+}
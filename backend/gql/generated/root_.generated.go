@@ -33,103 +33,2097 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	Event() EventResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Subscription() SubscriptionResolver
+	User() UserResolver
 }
 
 type DirectiveRoot struct {
+	Auth          func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error)
+	HasTenantRole func(ctx context.Context, obj any, next graphql.Resolver, role gqlmodel.TenantRole, tenantArg *string) (res any, err error)
+	Owner         func(ctx context.Context, obj any, next graphql.Resolver, resource gqlmodel.OwnedResource, idArg *string) (res any, err error)
 }
 
 type ComplexityRoot struct {
+	ApiKey struct {
+		CreatedAt  func(childComplexity int) int
+		ExpiresAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		LastUsedAt func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Prefix     func(childComplexity int) int
+		RevokedAt  func(childComplexity int) int
+		Scopes     func(childComplexity int) int
+		TenantID   func(childComplexity int) int
+	}
+
+	Certification struct {
+		CertType     func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		EducationID  func(childComplexity int) int
+		EmploymentID func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Proof        func(childComplexity int) int
+		ReviewReason func(childComplexity int) int
+		ReviewedAt   func(childComplexity int) int
+		ReviewerID   func(childComplexity int) int
+		Status       func(childComplexity int) int
+		UserID       func(childComplexity int) int
+	}
+
+	Comment struct {
+		Content      func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		Depth        func(childComplexity int) int
+		EventID      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ParentID     func(childComplexity int) int
+		Replies      func(childComplexity int) int
+		UserID       func(childComplexity int) int
+		UserNickname func(childComplexity int) int
+	}
+
+	CommentConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CommentEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	CreateApiKeyPayload struct {
+		APIKey func(childComplexity int) int
+		Key    func(childComplexity int) int
+	}
+
+	Education struct {
+		Degree     func(childComplexity int) int
+		ID         func(childComplexity int) int
+		IsVerified func(childComplexity int) int
+		Major      func(childComplexity int) int
+		SchoolName func(childComplexity int) int
+		SortOrder  func(childComplexity int) int
+		UserID     func(childComplexity int) int
+	}
+
+	Employment struct {
+		CompanyName func(childComplexity int) int
+		ID          func(childComplexity int) int
+		IsVerified  func(childComplexity int) int
+		JobTitle    func(childComplexity int) int
+		SortOrder   func(childComplexity int) int
+		UserID      func(childComplexity int) int
+	}
+
+	Event struct {
+		CancelReason     func(childComplexity int) int
+		CancelledAt      func(childComplexity int) int
+		CoverImage       func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		Description      func(childComplexity int) int
+		EndTime          func(childComplexity int) int
+		GroupID          func(childComplexity int) int
+		ID               func(childComplexity int) int
+		LocationDetail   func(childComplexity int) int
+		LocationType     func(childComplexity int) int
+		OwnerID          func(childComplexity int) int
+		OwnerNickname    func(childComplexity int) int
+		ParticipantLimit func(childComplexity int) int
+		Participants     func(childComplexity int, status *gqlmodel.ParticipantStatus) int
+		PrimaryTag       func(childComplexity int) int
+		RequireApproval  func(childComplexity int) int
+		SecondaryTags    func(childComplexity int) int
+		StartTime        func(childComplexity int) int
+		TenantID         func(childComplexity int) int
+		Title            func(childComplexity int) int
+	}
+
+	EventGroup struct {
+		CreatedAt         func(childComplexity int) int
+		Description       func(childComplexity int) int
+		EventCount        func(childComplexity int) int
+		ID                func(childComplexity int) int
+		IsPublic          func(childComplexity int) int
+		Name              func(childComplexity int) int
+		OwnerID           func(childComplexity int) int
+		Pictures          func(childComplexity int) int
+		RatingCount       func(childComplexity int) int
+		ScoreAvg          func(childComplexity int) int
+		Tags              func(childComplexity int) int
+		TotalParticipants func(childComplexity int) int
+	}
+
+	EventParticipant struct {
+		EventID  func(childComplexity int) int
+		ID       func(childComplexity int) int
+		JoinedAt func(childComplexity int) int
+		LeftAt   func(childComplexity int) int
+		Status   func(childComplexity int) int
+		UserID   func(childComplexity int) int
+	}
+
+	EventRating struct {
+		Comment   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		EventID   func(childComplexity int) int
+		ID        func(childComplexity int) int
+		RaterID   func(childComplexity int) int
+		Score     func(childComplexity int) int
+	}
+
 	Mutation struct {
-		UpsertUser func(childComplexity int, input gqlmodel.UpsertUserInput) int
+		AddEducation           func(childComplexity int, input gqlmodel.EducationInput) int
+		AddEmployment          func(childComplexity int, input gqlmodel.EmploymentInput) int
+		AddTenantMember        func(childComplexity int, tenantID string, userID string, role gqlmodel.TenantRole) int
+		ApproveCertification   func(childComplexity int, id string, reason *string) int
+		ApproveParticipant     func(childComplexity int, eventID string, userID string) int
+		CancelEvent            func(childComplexity int, id string, reason *string) int
+		ChangeTenantMemberRole func(childComplexity int, tenantID string, userID string, role gqlmodel.TenantRole) int
+		CreateAPIKey           func(childComplexity int, input gqlmodel.CreateAPIKeyInput) int
+		CreateEvent            func(childComplexity int, input gqlmodel.CreateEventInput) int
+		CreateGroup            func(childComplexity int, input gqlmodel.CreateGroupInput) int
+		CreateTenant           func(childComplexity int, input gqlmodel.CreateTenantInput) int
+		DeleteComment          func(childComplexity int, id string) int
+		DeleteEducation        func(childComplexity int, id string) int
+		DeleteEmployment       func(childComplexity int, id string) int
+		Follow                 func(childComplexity int, userID string) int
+		JoinEvent              func(childComplexity int, eventID string) int
+		LeaveEvent             func(childComplexity int, eventID string) int
+		MarkAllRead            func(childComplexity int) int
+		MarkAttendance         func(childComplexity int, eventID string, userID string, attended bool) int
+		MarkRead               func(childComplexity int, id string) int
+		PostComment            func(childComplexity int, input gqlmodel.PostCommentInput) int
+		RateEvent              func(childComplexity int, input gqlmodel.RateEventInput) int
+		RateParticipant        func(childComplexity int, input gqlmodel.RateParticipantInput) int
+		RejectCertification    func(childComplexity int, id string, reason string) int
+		RejectParticipant      func(childComplexity int, eventID string, userID string) int
+		RemoveTenantMember     func(childComplexity int, tenantID string, userID string) int
+		ReorderEducations      func(childComplexity int, ids []string) int
+		ReorderEmployments     func(childComplexity int, ids []string) int
+		RevokeAPIKey           func(childComplexity int, id string) int
+		RevokeAllSessions      func(childComplexity int) int
+		RevokeSession          func(childComplexity int, id string) int
+		SetEventGroup          func(childComplexity int, eventID string, groupID *string) int
+		SubmitCertification    func(childComplexity int, input gqlmodel.SubmitCertificationInput) int
+		SubscribeGroup         func(childComplexity int, id string) int
+		Unfollow               func(childComplexity int, userID string) int
+		UnsubscribeGroup       func(childComplexity int, id string) int
+		UpdateEducation        func(childComplexity int, id string, input gqlmodel.UpdateEducationInput) int
+		UpdateEmployment       func(childComplexity int, id string, input gqlmodel.UpdateEmploymentInput) int
+		UpdateEvent            func(childComplexity int, id string, input gqlmodel.UpdateEventInput) int
+		UpdateGroup            func(childComplexity int, id string, input gqlmodel.UpdateGroupInput) int
+		UpsertUser             func(childComplexity int, input gqlmodel.UpsertUserInput) int
+	}
+
+	Notification struct {
+		Channel   func(childComplexity int) int
+		Content   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		IsRead    func(childComplexity int) int
+		ReadAt    func(childComplexity int) int
+		Type      func(childComplexity int) int
+	}
+
+	NotificationConnection struct {
+		Edges       func(childComplexity int) int
+		PageInfo    func(childComplexity int) int
+		UnreadCount func(childComplexity int) int
+	}
+
+	NotificationEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
 	}
 
 	Query struct {
-		Me func(childComplexity int) int
+		APIKeys                 func(childComplexity int) int
+		CertificationQueue      func(childComplexity int, first *int32, after *string) int
+		Comments                func(childComplexity int, eventID string, first *int32, after *string) int
+		Event                   func(childComplexity int, id string) int
+		Events                  func(childComplexity int, filter *gqlmodel.EventFilter) int
+		Group                   func(childComplexity int, id string) int
+		Groups                  func(childComplexity int, limit *int32, offset *int32) int
+		Me                      func(childComplexity int) int
+		MyCertifications        func(childComplexity int) int
+		MySessions              func(childComplexity int) int
+		MySubscribedGroupEvents func(childComplexity int, limit *int32, offset *int32) int
+		MyTenants               func(childComplexity int) int
+		Notifications           func(childComplexity int, first *int32, after *string, unreadOnly *bool) int
+		TenantMembers           func(childComplexity int, tenantID string) int
+		UnreadNotificationCount func(childComplexity int) int
+	}
+
+	Session struct {
+		CreatedAt  func(childComplexity int) int
+		Current    func(childComplexity int) int
+		ExpiresAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		IPAddress  func(childComplexity int) int
+		Issuer     func(childComplexity int) int
+		LastSeenAt func(childComplexity int) int
+		UserAgent  func(childComplexity int) int
+	}
+
+	Subscription struct {
+		CommentAdded      func(childComplexity int, eventID string) int
+		EventUpdated      func(childComplexity int, id string) int
+		NotificationAdded func(childComplexity int) int
+	}
+
+	Tenant struct {
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Region    func(childComplexity int) int
+	}
+
+	TenantMember struct {
+		JoinedAt func(childComplexity int) int
+		Role     func(childComplexity int) int
+		TenantID func(childComplexity int) int
+		UserID   func(childComplexity int) int
+	}
+
+	TenantMembership struct {
+		JoinedAt func(childComplexity int) int
+		Role     func(childComplexity int) int
+		Tenant   func(childComplexity int) int
+	}
+
+	User struct {
+		Avatar          func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Educations      func(childComplexity int) int
+		Employments     func(childComplexity int) int
+		FollowerCount   func(childComplexity int) int
+		Followers       func(childComplexity int, first *int32, after *string) int
+		Following       func(childComplexity int, first *int32, after *string) int
+		FollowingCount  func(childComplexity int) int
+		ID              func(childComplexity int) int
+		IsFollowedByMe  func(childComplexity int) int
+		IsMutualFollow  func(childComplexity int) int
+		IsPlatformAdmin func(childComplexity int) int
+		Nickname        func(childComplexity int) int
+		RatingAvg       func(childComplexity int) int
+		RatingCount     func(childComplexity int) int
+		UID             func(childComplexity int) int
+	}
+
+	UserConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	UserEdge struct {
+		Cursor     func(childComplexity int) int
+		FollowedAt func(childComplexity int) int
+		Node       func(childComplexity int) int
+	}
+
+	UserRating struct {
+		Comment      func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		EventID      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		RaterID      func(childComplexity int) int
+		Score        func(childComplexity int) int
+		TargetUserID func(childComplexity int) int
+	}
+}
+
+type executableSchema struct {
+	schema     *ast.Schema
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	if e.schema != nil {
+		return e.schema
 	}
+	return parsedSchema
+}
+
+func (e *executableSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, rawArgs map[string]any) (int, bool) {
+	ec := executionContext{nil, e, 0, 0, nil}
+	_ = ec
+	switch typeName + "." + field {
+
+	case "ApiKey.createdAt":
+		if e.complexity.ApiKey.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.CreatedAt(childComplexity), true
+
+	case "ApiKey.expiresAt":
+		if e.complexity.ApiKey.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.ExpiresAt(childComplexity), true
+
+	case "ApiKey.id":
+		if e.complexity.ApiKey.ID == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.ID(childComplexity), true
+
+	case "ApiKey.lastUsedAt":
+		if e.complexity.ApiKey.LastUsedAt == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.LastUsedAt(childComplexity), true
+
+	case "ApiKey.name":
+		if e.complexity.ApiKey.Name == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.Name(childComplexity), true
+
+	case "ApiKey.prefix":
+		if e.complexity.ApiKey.Prefix == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.Prefix(childComplexity), true
+
+	case "ApiKey.revokedAt":
+		if e.complexity.ApiKey.RevokedAt == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.RevokedAt(childComplexity), true
+
+	case "ApiKey.scopes":
+		if e.complexity.ApiKey.Scopes == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.Scopes(childComplexity), true
+
+	case "ApiKey.tenantId":
+		if e.complexity.ApiKey.TenantID == nil {
+			break
+		}
+
+		return e.complexity.ApiKey.TenantID(childComplexity), true
+
+	case "Certification.certType":
+		if e.complexity.Certification.CertType == nil {
+			break
+		}
+
+		return e.complexity.Certification.CertType(childComplexity), true
+
+	case "Certification.createdAt":
+		if e.complexity.Certification.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Certification.CreatedAt(childComplexity), true
+
+	case "Certification.educationId":
+		if e.complexity.Certification.EducationID == nil {
+			break
+		}
+
+		return e.complexity.Certification.EducationID(childComplexity), true
+
+	case "Certification.employmentId":
+		if e.complexity.Certification.EmploymentID == nil {
+			break
+		}
+
+		return e.complexity.Certification.EmploymentID(childComplexity), true
+
+	case "Certification.id":
+		if e.complexity.Certification.ID == nil {
+			break
+		}
+
+		return e.complexity.Certification.ID(childComplexity), true
+
+	case "Certification.proof":
+		if e.complexity.Certification.Proof == nil {
+			break
+		}
+
+		return e.complexity.Certification.Proof(childComplexity), true
+
+	case "Certification.reviewReason":
+		if e.complexity.Certification.ReviewReason == nil {
+			break
+		}
+
+		return e.complexity.Certification.ReviewReason(childComplexity), true
+
+	case "Certification.reviewedAt":
+		if e.complexity.Certification.ReviewedAt == nil {
+			break
+		}
+
+		return e.complexity.Certification.ReviewedAt(childComplexity), true
+
+	case "Certification.reviewerId":
+		if e.complexity.Certification.ReviewerID == nil {
+			break
+		}
+
+		return e.complexity.Certification.ReviewerID(childComplexity), true
+
+	case "Certification.status":
+		if e.complexity.Certification.Status == nil {
+			break
+		}
+
+		return e.complexity.Certification.Status(childComplexity), true
+
+	case "Certification.userId":
+		if e.complexity.Certification.UserID == nil {
+			break
+		}
+
+		return e.complexity.Certification.UserID(childComplexity), true
+
+	case "Comment.content":
+		if e.complexity.Comment.Content == nil {
+			break
+		}
+
+		return e.complexity.Comment.Content(childComplexity), true
+
+	case "Comment.createdAt":
+		if e.complexity.Comment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Comment.CreatedAt(childComplexity), true
+
+	case "Comment.depth":
+		if e.complexity.Comment.Depth == nil {
+			break
+		}
+
+		return e.complexity.Comment.Depth(childComplexity), true
+
+	case "Comment.eventId":
+		if e.complexity.Comment.EventID == nil {
+			break
+		}
+
+		return e.complexity.Comment.EventID(childComplexity), true
+
+	case "Comment.id":
+		if e.complexity.Comment.ID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ID(childComplexity), true
+
+	case "Comment.parentId":
+		if e.complexity.Comment.ParentID == nil {
+			break
+		}
+
+		return e.complexity.Comment.ParentID(childComplexity), true
+
+	case "Comment.replies":
+		if e.complexity.Comment.Replies == nil {
+			break
+		}
+
+		return e.complexity.Comment.Replies(childComplexity), true
+
+	case "Comment.userId":
+		if e.complexity.Comment.UserID == nil {
+			break
+		}
+
+		return e.complexity.Comment.UserID(childComplexity), true
+
+	case "Comment.userNickname":
+		if e.complexity.Comment.UserNickname == nil {
+			break
+		}
+
+		return e.complexity.Comment.UserNickname(childComplexity), true
+
+	case "CommentConnection.edges":
+		if e.complexity.CommentConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.Edges(childComplexity), true
+
+	case "CommentConnection.pageInfo":
+		if e.complexity.CommentConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.PageInfo(childComplexity), true
+
+	case "CommentConnection.totalCount":
+		if e.complexity.CommentConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CommentConnection.TotalCount(childComplexity), true
+
+	case "CommentEdge.cursor":
+		if e.complexity.CommentEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Cursor(childComplexity), true
+
+	case "CommentEdge.node":
+		if e.complexity.CommentEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CommentEdge.Node(childComplexity), true
+
+	case "CreateApiKeyPayload.apiKey":
+		if e.complexity.CreateApiKeyPayload.APIKey == nil {
+			break
+		}
+
+		return e.complexity.CreateApiKeyPayload.APIKey(childComplexity), true
+
+	case "CreateApiKeyPayload.key":
+		if e.complexity.CreateApiKeyPayload.Key == nil {
+			break
+		}
+
+		return e.complexity.CreateApiKeyPayload.Key(childComplexity), true
+
+	case "Education.degree":
+		if e.complexity.Education.Degree == nil {
+			break
+		}
+
+		return e.complexity.Education.Degree(childComplexity), true
+
+	case "Education.id":
+		if e.complexity.Education.ID == nil {
+			break
+		}
+
+		return e.complexity.Education.ID(childComplexity), true
+
+	case "Education.isVerified":
+		if e.complexity.Education.IsVerified == nil {
+			break
+		}
+
+		return e.complexity.Education.IsVerified(childComplexity), true
+
+	case "Education.major":
+		if e.complexity.Education.Major == nil {
+			break
+		}
+
+		return e.complexity.Education.Major(childComplexity), true
+
+	case "Education.schoolName":
+		if e.complexity.Education.SchoolName == nil {
+			break
+		}
+
+		return e.complexity.Education.SchoolName(childComplexity), true
+
+	case "Education.sortOrder":
+		if e.complexity.Education.SortOrder == nil {
+			break
+		}
+
+		return e.complexity.Education.SortOrder(childComplexity), true
+
+	case "Education.userId":
+		if e.complexity.Education.UserID == nil {
+			break
+		}
+
+		return e.complexity.Education.UserID(childComplexity), true
+
+	case "Employment.companyName":
+		if e.complexity.Employment.CompanyName == nil {
+			break
+		}
+
+		return e.complexity.Employment.CompanyName(childComplexity), true
+
+	case "Employment.id":
+		if e.complexity.Employment.ID == nil {
+			break
+		}
+
+		return e.complexity.Employment.ID(childComplexity), true
+
+	case "Employment.isVerified":
+		if e.complexity.Employment.IsVerified == nil {
+			break
+		}
+
+		return e.complexity.Employment.IsVerified(childComplexity), true
+
+	case "Employment.jobTitle":
+		if e.complexity.Employment.JobTitle == nil {
+			break
+		}
+
+		return e.complexity.Employment.JobTitle(childComplexity), true
+
+	case "Employment.sortOrder":
+		if e.complexity.Employment.SortOrder == nil {
+			break
+		}
+
+		return e.complexity.Employment.SortOrder(childComplexity), true
+
+	case "Employment.userId":
+		if e.complexity.Employment.UserID == nil {
+			break
+		}
+
+		return e.complexity.Employment.UserID(childComplexity), true
+
+	case "Event.cancelReason":
+		if e.complexity.Event.CancelReason == nil {
+			break
+		}
+
+		return e.complexity.Event.CancelReason(childComplexity), true
+
+	case "Event.cancelledAt":
+		if e.complexity.Event.CancelledAt == nil {
+			break
+		}
+
+		return e.complexity.Event.CancelledAt(childComplexity), true
+
+	case "Event.coverImage":
+		if e.complexity.Event.CoverImage == nil {
+			break
+		}
+
+		return e.complexity.Event.CoverImage(childComplexity), true
+
+	case "Event.createdAt":
+		if e.complexity.Event.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Event.CreatedAt(childComplexity), true
+
+	case "Event.description":
+		if e.complexity.Event.Description == nil {
+			break
+		}
+
+		return e.complexity.Event.Description(childComplexity), true
+
+	case "Event.endTime":
+		if e.complexity.Event.EndTime == nil {
+			break
+		}
+
+		return e.complexity.Event.EndTime(childComplexity), true
+
+	case "Event.groupId":
+		if e.complexity.Event.GroupID == nil {
+			break
+		}
+
+		return e.complexity.Event.GroupID(childComplexity), true
+
+	case "Event.id":
+		if e.complexity.Event.ID == nil {
+			break
+		}
+
+		return e.complexity.Event.ID(childComplexity), true
+
+	case "Event.locationDetail":
+		if e.complexity.Event.LocationDetail == nil {
+			break
+		}
+
+		return e.complexity.Event.LocationDetail(childComplexity), true
+
+	case "Event.locationType":
+		if e.complexity.Event.LocationType == nil {
+			break
+		}
+
+		return e.complexity.Event.LocationType(childComplexity), true
+
+	case "Event.ownerId":
+		if e.complexity.Event.OwnerID == nil {
+			break
+		}
+
+		return e.complexity.Event.OwnerID(childComplexity), true
+
+	case "Event.ownerNickname":
+		if e.complexity.Event.OwnerNickname == nil {
+			break
+		}
+
+		return e.complexity.Event.OwnerNickname(childComplexity), true
+
+	case "Event.participantLimit":
+		if e.complexity.Event.ParticipantLimit == nil {
+			break
+		}
+
+		return e.complexity.Event.ParticipantLimit(childComplexity), true
+
+	case "Event.participants":
+		if e.complexity.Event.Participants == nil {
+			break
+		}
+
+		args, err := ec.field_Event_participants_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Event.Participants(childComplexity, args["status"].(*gqlmodel.ParticipantStatus)), true
+
+	case "Event.primaryTag":
+		if e.complexity.Event.PrimaryTag == nil {
+			break
+		}
+
+		return e.complexity.Event.PrimaryTag(childComplexity), true
+
+	case "Event.requireApproval":
+		if e.complexity.Event.RequireApproval == nil {
+			break
+		}
+
+		return e.complexity.Event.RequireApproval(childComplexity), true
+
+	case "Event.secondaryTags":
+		if e.complexity.Event.SecondaryTags == nil {
+			break
+		}
+
+		return e.complexity.Event.SecondaryTags(childComplexity), true
+
+	case "Event.startTime":
+		if e.complexity.Event.StartTime == nil {
+			break
+		}
+
+		return e.complexity.Event.StartTime(childComplexity), true
+
+	case "Event.tenantId":
+		if e.complexity.Event.TenantID == nil {
+			break
+		}
+
+		return e.complexity.Event.TenantID(childComplexity), true
+
+	case "Event.title":
+		if e.complexity.Event.Title == nil {
+			break
+		}
+
+		return e.complexity.Event.Title(childComplexity), true
+
+	case "EventGroup.createdAt":
+		if e.complexity.EventGroup.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.CreatedAt(childComplexity), true
+
+	case "EventGroup.description":
+		if e.complexity.EventGroup.Description == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.Description(childComplexity), true
+
+	case "EventGroup.eventCount":
+		if e.complexity.EventGroup.EventCount == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.EventCount(childComplexity), true
+
+	case "EventGroup.id":
+		if e.complexity.EventGroup.ID == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.ID(childComplexity), true
+
+	case "EventGroup.isPublic":
+		if e.complexity.EventGroup.IsPublic == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.IsPublic(childComplexity), true
+
+	case "EventGroup.name":
+		if e.complexity.EventGroup.Name == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.Name(childComplexity), true
+
+	case "EventGroup.ownerId":
+		if e.complexity.EventGroup.OwnerID == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.OwnerID(childComplexity), true
+
+	case "EventGroup.pictures":
+		if e.complexity.EventGroup.Pictures == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.Pictures(childComplexity), true
+
+	case "EventGroup.ratingCount":
+		if e.complexity.EventGroup.RatingCount == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.RatingCount(childComplexity), true
+
+	case "EventGroup.scoreAvg":
+		if e.complexity.EventGroup.ScoreAvg == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.ScoreAvg(childComplexity), true
+
+	case "EventGroup.tags":
+		if e.complexity.EventGroup.Tags == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.Tags(childComplexity), true
+
+	case "EventGroup.totalParticipants":
+		if e.complexity.EventGroup.TotalParticipants == nil {
+			break
+		}
+
+		return e.complexity.EventGroup.TotalParticipants(childComplexity), true
+
+	case "EventParticipant.eventId":
+		if e.complexity.EventParticipant.EventID == nil {
+			break
+		}
+
+		return e.complexity.EventParticipant.EventID(childComplexity), true
+
+	case "EventParticipant.id":
+		if e.complexity.EventParticipant.ID == nil {
+			break
+		}
+
+		return e.complexity.EventParticipant.ID(childComplexity), true
+
+	case "EventParticipant.joinedAt":
+		if e.complexity.EventParticipant.JoinedAt == nil {
+			break
+		}
+
+		return e.complexity.EventParticipant.JoinedAt(childComplexity), true
+
+	case "EventParticipant.leftAt":
+		if e.complexity.EventParticipant.LeftAt == nil {
+			break
+		}
+
+		return e.complexity.EventParticipant.LeftAt(childComplexity), true
+
+	case "EventParticipant.status":
+		if e.complexity.EventParticipant.Status == nil {
+			break
+		}
+
+		return e.complexity.EventParticipant.Status(childComplexity), true
+
+	case "EventParticipant.userId":
+		if e.complexity.EventParticipant.UserID == nil {
+			break
+		}
+
+		return e.complexity.EventParticipant.UserID(childComplexity), true
+
+	case "EventRating.comment":
+		if e.complexity.EventRating.Comment == nil {
+			break
+		}
+
+		return e.complexity.EventRating.Comment(childComplexity), true
+
+	case "EventRating.createdAt":
+		if e.complexity.EventRating.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.EventRating.CreatedAt(childComplexity), true
+
+	case "EventRating.eventId":
+		if e.complexity.EventRating.EventID == nil {
+			break
+		}
+
+		return e.complexity.EventRating.EventID(childComplexity), true
+
+	case "EventRating.id":
+		if e.complexity.EventRating.ID == nil {
+			break
+		}
+
+		return e.complexity.EventRating.ID(childComplexity), true
+
+	case "EventRating.raterId":
+		if e.complexity.EventRating.RaterID == nil {
+			break
+		}
+
+		return e.complexity.EventRating.RaterID(childComplexity), true
+
+	case "EventRating.score":
+		if e.complexity.EventRating.Score == nil {
+			break
+		}
+
+		return e.complexity.EventRating.Score(childComplexity), true
+
+	case "Mutation.addEducation":
+		if e.complexity.Mutation.AddEducation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addEducation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddEducation(childComplexity, args["input"].(gqlmodel.EducationInput)), true
+
+	case "Mutation.addEmployment":
+		if e.complexity.Mutation.AddEmployment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addEmployment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddEmployment(childComplexity, args["input"].(gqlmodel.EmploymentInput)), true
+
+	case "Mutation.addTenantMember":
+		if e.complexity.Mutation.AddTenantMember == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addTenantMember_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddTenantMember(childComplexity, args["tenantId"].(string), args["userId"].(string), args["role"].(gqlmodel.TenantRole)), true
+
+	case "Mutation.approveCertification":
+		if e.complexity.Mutation.ApproveCertification == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveCertification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveCertification(childComplexity, args["id"].(string), args["reason"].(*string)), true
+
+	case "Mutation.approveParticipant":
+		if e.complexity.Mutation.ApproveParticipant == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveParticipant_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveParticipant(childComplexity, args["eventId"].(string), args["userId"].(string)), true
+
+	case "Mutation.cancelEvent":
+		if e.complexity.Mutation.CancelEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelEvent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelEvent(childComplexity, args["id"].(string), args["reason"].(*string)), true
+
+	case "Mutation.changeTenantMemberRole":
+		if e.complexity.Mutation.ChangeTenantMemberRole == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_changeTenantMemberRole_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ChangeTenantMemberRole(childComplexity, args["tenantId"].(string), args["userId"].(string), args["role"].(gqlmodel.TenantRole)), true
+
+	case "Mutation.createApiKey":
+		if e.complexity.Mutation.CreateAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createApiKey_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIKey(childComplexity, args["input"].(gqlmodel.CreateAPIKeyInput)), true
+
+	case "Mutation.createEvent":
+		if e.complexity.Mutation.CreateEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createEvent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateEvent(childComplexity, args["input"].(gqlmodel.CreateEventInput)), true
+
+	case "Mutation.createGroup":
+		if e.complexity.Mutation.CreateGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateGroup(childComplexity, args["input"].(gqlmodel.CreateGroupInput)), true
+
+	case "Mutation.createTenant":
+		if e.complexity.Mutation.CreateTenant == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createTenant_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateTenant(childComplexity, args["input"].(gqlmodel.CreateTenantInput)), true
+
+	case "Mutation.deleteComment":
+		if e.complexity.Mutation.DeleteComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteComment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteEducation":
+		if e.complexity.Mutation.DeleteEducation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteEducation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteEducation(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteEmployment":
+		if e.complexity.Mutation.DeleteEmployment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteEmployment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteEmployment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.follow":
+		if e.complexity.Mutation.Follow == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_follow_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Follow(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.joinEvent":
+		if e.complexity.Mutation.JoinEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_joinEvent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.JoinEvent(childComplexity, args["eventId"].(string)), true
+
+	case "Mutation.leaveEvent":
+		if e.complexity.Mutation.LeaveEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_leaveEvent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LeaveEvent(childComplexity, args["eventId"].(string)), true
+
+	case "Mutation.markAllRead":
+		if e.complexity.Mutation.MarkAllRead == nil {
+			break
+		}
+
+		return e.complexity.Mutation.MarkAllRead(childComplexity), true
+
+	case "Mutation.markAttendance":
+		if e.complexity.Mutation.MarkAttendance == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_markAttendance_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MarkAttendance(childComplexity, args["eventId"].(string), args["userId"].(string), args["attended"].(bool)), true
+
+	case "Mutation.markRead":
+		if e.complexity.Mutation.MarkRead == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_markRead_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MarkRead(childComplexity, args["id"].(string)), true
+
+	case "Mutation.postComment":
+		if e.complexity.Mutation.PostComment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_postComment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PostComment(childComplexity, args["input"].(gqlmodel.PostCommentInput)), true
+
+	case "Mutation.rateEvent":
+		if e.complexity.Mutation.RateEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rateEvent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RateEvent(childComplexity, args["input"].(gqlmodel.RateEventInput)), true
+
+	case "Mutation.rateParticipant":
+		if e.complexity.Mutation.RateParticipant == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rateParticipant_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RateParticipant(childComplexity, args["input"].(gqlmodel.RateParticipantInput)), true
+
+	case "Mutation.rejectCertification":
+		if e.complexity.Mutation.RejectCertification == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rejectCertification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RejectCertification(childComplexity, args["id"].(string), args["reason"].(string)), true
+
+	case "Mutation.rejectParticipant":
+		if e.complexity.Mutation.RejectParticipant == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rejectParticipant_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RejectParticipant(childComplexity, args["eventId"].(string), args["userId"].(string)), true
+
+	case "Mutation.removeTenantMember":
+		if e.complexity.Mutation.RemoveTenantMember == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeTenantMember_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveTenantMember(childComplexity, args["tenantId"].(string), args["userId"].(string)), true
+
+	case "Mutation.reorderEducations":
+		if e.complexity.Mutation.ReorderEducations == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_reorderEducations_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReorderEducations(childComplexity, args["ids"].([]string)), true
+
+	case "Mutation.reorderEmployments":
+		if e.complexity.Mutation.ReorderEmployments == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_reorderEmployments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReorderEmployments(childComplexity, args["ids"].([]string)), true
+
+	case "Mutation.revokeApiKey":
+		if e.complexity.Mutation.RevokeAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeApiKey_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIKey(childComplexity, args["id"].(string)), true
+
+	case "Mutation.revokeAllSessions":
+		if e.complexity.Mutation.RevokeAllSessions == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RevokeAllSessions(childComplexity), true
+
+	case "Mutation.revokeSession":
+		if e.complexity.Mutation.RevokeSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeSession_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeSession(childComplexity, args["id"].(string)), true
+
+	case "Mutation.setEventGroup":
+		if e.complexity.Mutation.SetEventGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setEventGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetEventGroup(childComplexity, args["eventId"].(string), args["groupId"].(*string)), true
+
+	case "Mutation.submitCertification":
+		if e.complexity.Mutation.SubmitCertification == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_submitCertification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SubmitCertification(childComplexity, args["input"].(gqlmodel.SubmitCertificationInput)), true
+
+	case "Mutation.subscribeGroup":
+		if e.complexity.Mutation.SubscribeGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_subscribeGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SubscribeGroup(childComplexity, args["id"].(string)), true
+
+	case "Mutation.unfollow":
+		if e.complexity.Mutation.Unfollow == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unfollow_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Unfollow(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.unsubscribeGroup":
+		if e.complexity.Mutation.UnsubscribeGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unsubscribeGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnsubscribeGroup(childComplexity, args["id"].(string)), true
+
+	case "Mutation.updateEducation":
+		if e.complexity.Mutation.UpdateEducation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateEducation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateEducation(childComplexity, args["id"].(string), args["input"].(gqlmodel.UpdateEducationInput)), true
+
+	case "Mutation.updateEmployment":
+		if e.complexity.Mutation.UpdateEmployment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateEmployment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateEmployment(childComplexity, args["id"].(string), args["input"].(gqlmodel.UpdateEmploymentInput)), true
+
+	case "Mutation.updateEvent":
+		if e.complexity.Mutation.UpdateEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateEvent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateEvent(childComplexity, args["id"].(string), args["input"].(gqlmodel.UpdateEventInput)), true
+
+	case "Mutation.updateGroup":
+		if e.complexity.Mutation.UpdateGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateGroup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateGroup(childComplexity, args["id"].(string), args["input"].(gqlmodel.UpdateGroupInput)), true
+
+	case "Mutation.upsertUser":
+		if e.complexity.Mutation.UpsertUser == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_upsertUser_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpsertUser(childComplexity, args["input"].(gqlmodel.UpsertUserInput)), true
+
+	case "Notification.channel":
+		if e.complexity.Notification.Channel == nil {
+			break
+		}
+
+		return e.complexity.Notification.Channel(childComplexity), true
+
+	case "Notification.content":
+		if e.complexity.Notification.Content == nil {
+			break
+		}
+
+		return e.complexity.Notification.Content(childComplexity), true
+
+	case "Notification.createdAt":
+		if e.complexity.Notification.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Notification.CreatedAt(childComplexity), true
+
+	case "Notification.id":
+		if e.complexity.Notification.ID == nil {
+			break
+		}
+
+		return e.complexity.Notification.ID(childComplexity), true
+
+	case "Notification.isRead":
+		if e.complexity.Notification.IsRead == nil {
+			break
+		}
+
+		return e.complexity.Notification.IsRead(childComplexity), true
+
+	case "Notification.readAt":
+		if e.complexity.Notification.ReadAt == nil {
+			break
+		}
+
+		return e.complexity.Notification.ReadAt(childComplexity), true
+
+	case "Notification.type":
+		if e.complexity.Notification.Type == nil {
+			break
+		}
+
+		return e.complexity.Notification.Type(childComplexity), true
+
+	case "NotificationConnection.edges":
+		if e.complexity.NotificationConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.NotificationConnection.Edges(childComplexity), true
+
+	case "NotificationConnection.pageInfo":
+		if e.complexity.NotificationConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.NotificationConnection.PageInfo(childComplexity), true
+
+	case "NotificationConnection.unreadCount":
+		if e.complexity.NotificationConnection.UnreadCount == nil {
+			break
+		}
+
+		return e.complexity.NotificationConnection.UnreadCount(childComplexity), true
+
+	case "NotificationEdge.cursor":
+		if e.complexity.NotificationEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.NotificationEdge.Cursor(childComplexity), true
+
+	case "NotificationEdge.node":
+		if e.complexity.NotificationEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.NotificationEdge.Node(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "Query.apiKeys":
+		if e.complexity.Query.APIKeys == nil {
+			break
+		}
+
+		return e.complexity.Query.APIKeys(childComplexity), true
+
+	case "Query.certificationQueue":
+		if e.complexity.Query.CertificationQueue == nil {
+			break
+		}
+
+		args, err := ec.field_Query_certificationQueue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CertificationQueue(childComplexity, args["first"].(*int32), args["after"].(*string)), true
+
+	case "Query.comments":
+		if e.complexity.Query.Comments == nil {
+			break
+		}
+
+		args, err := ec.field_Query_comments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Comments(childComplexity, args["eventId"].(string), args["first"].(*int32), args["after"].(*string)), true
+
+	case "Query.event":
+		if e.complexity.Query.Event == nil {
+			break
+		}
+
+		args, err := ec.field_Query_event_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Event(childComplexity, args["id"].(string)), true
+
+	case "Query.events":
+		if e.complexity.Query.Events == nil {
+			break
+		}
+
+		args, err := ec.field_Query_events_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Events(childComplexity, args["filter"].(*gqlmodel.EventFilter)), true
+
+	case "Query.group":
+		if e.complexity.Query.Group == nil {
+			break
+		}
+
+		args, err := ec.field_Query_group_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Group(childComplexity, args["id"].(string)), true
+
+	case "Query.groups":
+		if e.complexity.Query.Groups == nil {
+			break
+		}
+
+		args, err := ec.field_Query_groups_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Groups(childComplexity, args["limit"].(*int32), args["offset"].(*int32)), true
+
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
+
+		return e.complexity.Query.Me(childComplexity), true
+
+	case "Query.myCertifications":
+		if e.complexity.Query.MyCertifications == nil {
+			break
+		}
+
+		return e.complexity.Query.MyCertifications(childComplexity), true
+
+	case "Query.mySessions":
+		if e.complexity.Query.MySessions == nil {
+			break
+		}
+
+		return e.complexity.Query.MySessions(childComplexity), true
+
+	case "Query.mySubscribedGroupEvents":
+		if e.complexity.Query.MySubscribedGroupEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_mySubscribedGroupEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MySubscribedGroupEvents(childComplexity, args["limit"].(*int32), args["offset"].(*int32)), true
+
+	case "Query.myTenants":
+		if e.complexity.Query.MyTenants == nil {
+			break
+		}
+
+		return e.complexity.Query.MyTenants(childComplexity), true
+
+	case "Query.notifications":
+		if e.complexity.Query.Notifications == nil {
+			break
+		}
+
+		args, err := ec.field_Query_notifications_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Notifications(childComplexity, args["first"].(*int32), args["after"].(*string), args["unreadOnly"].(*bool)), true
+
+	case "Query.tenantMembers":
+		if e.complexity.Query.TenantMembers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_tenantMembers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TenantMembers(childComplexity, args["tenantId"].(string)), true
+
+	case "Query.unreadNotificationCount":
+		if e.complexity.Query.UnreadNotificationCount == nil {
+			break
+		}
+
+		return e.complexity.Query.UnreadNotificationCount(childComplexity), true
+
+	case "Session.createdAt":
+		if e.complexity.Session.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Session.CreatedAt(childComplexity), true
+
+	case "Session.current":
+		if e.complexity.Session.Current == nil {
+			break
+		}
+
+		return e.complexity.Session.Current(childComplexity), true
+
+	case "Session.expiresAt":
+		if e.complexity.Session.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.Session.ExpiresAt(childComplexity), true
+
+	case "Session.id":
+		if e.complexity.Session.ID == nil {
+			break
+		}
+
+		return e.complexity.Session.ID(childComplexity), true
+
+	case "Session.ipAddress":
+		if e.complexity.Session.IPAddress == nil {
+			break
+		}
+
+		return e.complexity.Session.IPAddress(childComplexity), true
+
+	case "Session.issuer":
+		if e.complexity.Session.Issuer == nil {
+			break
+		}
+
+		return e.complexity.Session.Issuer(childComplexity), true
+
+	case "Session.lastSeenAt":
+		if e.complexity.Session.LastSeenAt == nil {
+			break
+		}
+
+		return e.complexity.Session.LastSeenAt(childComplexity), true
+
+	case "Session.userAgent":
+		if e.complexity.Session.UserAgent == nil {
+			break
+		}
+
+		return e.complexity.Session.UserAgent(childComplexity), true
+
+	case "Subscription.commentAdded":
+		if e.complexity.Subscription.CommentAdded == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_commentAdded_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.CommentAdded(childComplexity, args["eventId"].(string)), true
+
+	case "Subscription.eventUpdated":
+		if e.complexity.Subscription.EventUpdated == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_eventUpdated_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.EventUpdated(childComplexity, args["id"].(string)), true
+
+	case "Subscription.notificationAdded":
+		if e.complexity.Subscription.NotificationAdded == nil {
+			break
+		}
+
+		return e.complexity.Subscription.NotificationAdded(childComplexity), true
+
+	case "Tenant.createdAt":
+		if e.complexity.Tenant.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Tenant.CreatedAt(childComplexity), true
+
+	case "Tenant.id":
+		if e.complexity.Tenant.ID == nil {
+			break
+		}
+
+		return e.complexity.Tenant.ID(childComplexity), true
+
+	case "Tenant.name":
+		if e.complexity.Tenant.Name == nil {
+			break
+		}
+
+		return e.complexity.Tenant.Name(childComplexity), true
+
+	case "Tenant.region":
+		if e.complexity.Tenant.Region == nil {
+			break
+		}
+
+		return e.complexity.Tenant.Region(childComplexity), true
+
+	case "TenantMember.joinedAt":
+		if e.complexity.TenantMember.JoinedAt == nil {
+			break
+		}
+
+		return e.complexity.TenantMember.JoinedAt(childComplexity), true
+
+	case "TenantMember.role":
+		if e.complexity.TenantMember.Role == nil {
+			break
+		}
+
+		return e.complexity.TenantMember.Role(childComplexity), true
+
+	case "TenantMember.tenantId":
+		if e.complexity.TenantMember.TenantID == nil {
+			break
+		}
+
+		return e.complexity.TenantMember.TenantID(childComplexity), true
+
+	case "TenantMember.userId":
+		if e.complexity.TenantMember.UserID == nil {
+			break
+		}
+
+		return e.complexity.TenantMember.UserID(childComplexity), true
+
+	case "TenantMembership.joinedAt":
+		if e.complexity.TenantMembership.JoinedAt == nil {
+			break
+		}
+
+		return e.complexity.TenantMembership.JoinedAt(childComplexity), true
+
+	case "TenantMembership.role":
+		if e.complexity.TenantMembership.Role == nil {
+			break
+		}
+
+		return e.complexity.TenantMembership.Role(childComplexity), true
+
+	case "TenantMembership.tenant":
+		if e.complexity.TenantMembership.Tenant == nil {
+			break
+		}
+
+		return e.complexity.TenantMembership.Tenant(childComplexity), true
+
+	case "User.avatar":
+		if e.complexity.User.Avatar == nil {
+			break
+		}
+
+		return e.complexity.User.Avatar(childComplexity), true
+
+	case "User.createdAt":
+		if e.complexity.User.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.User.CreatedAt(childComplexity), true
+
+	case "User.educations":
+		if e.complexity.User.Educations == nil {
+			break
+		}
+
+		return e.complexity.User.Educations(childComplexity), true
+
+	case "User.employments":
+		if e.complexity.User.Employments == nil {
+			break
+		}
+
+		return e.complexity.User.Employments(childComplexity), true
+
+	case "User.followerCount":
+		if e.complexity.User.FollowerCount == nil {
+			break
+		}
+
+		return e.complexity.User.FollowerCount(childComplexity), true
+
+	case "User.followers":
+		if e.complexity.User.Followers == nil {
+			break
+		}
+
+		args, err := ec.field_User_followers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Followers(childComplexity, args["first"].(*int32), args["after"].(*string)), true
+
+	case "User.following":
+		if e.complexity.User.Following == nil {
+			break
+		}
+
+		args, err := ec.field_User_following_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.User.Following(childComplexity, args["first"].(*int32), args["after"].(*string)), true
+
+	case "User.followingCount":
+		if e.complexity.User.FollowingCount == nil {
+			break
+		}
+
+		return e.complexity.User.FollowingCount(childComplexity), true
+
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+
+	case "User.isFollowedByMe":
+		if e.complexity.User.IsFollowedByMe == nil {
+			break
+		}
+
+		return e.complexity.User.IsFollowedByMe(childComplexity), true
+
+	case "User.isMutualFollow":
+		if e.complexity.User.IsMutualFollow == nil {
+			break
+		}
+
+		return e.complexity.User.IsMutualFollow(childComplexity), true
+
+	case "User.isPlatformAdmin":
+		if e.complexity.User.IsPlatformAdmin == nil {
+			break
+		}
+
+		return e.complexity.User.IsPlatformAdmin(childComplexity), true
+
+	case "User.nickname":
+		if e.complexity.User.Nickname == nil {
+			break
+		}
+
+		return e.complexity.User.Nickname(childComplexity), true
+
+	case "User.ratingAvg":
+		if e.complexity.User.RatingAvg == nil {
+			break
+		}
+
+		return e.complexity.User.RatingAvg(childComplexity), true
+
+	case "User.ratingCount":
+		if e.complexity.User.RatingCount == nil {
+			break
+		}
+
+		return e.complexity.User.RatingCount(childComplexity), true
+
+	case "User.uid":
+		if e.complexity.User.UID == nil {
+			break
+		}
+
+		return e.complexity.User.UID(childComplexity), true
+
+	case "UserConnection.edges":
+		if e.complexity.UserConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.Edges(childComplexity), true
+
+	case "UserConnection.pageInfo":
+		if e.complexity.UserConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.PageInfo(childComplexity), true
+
+	case "UserConnection.totalCount":
+		if e.complexity.UserConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.UserConnection.TotalCount(childComplexity), true
 
-	User struct {
-		Avatar    func(childComplexity int) int
-		CreatedAt func(childComplexity int) int
-		ID        func(childComplexity int) int
-		Nickname  func(childComplexity int) int
-		UID       func(childComplexity int) int
-	}
-}
+	case "UserEdge.cursor":
+		if e.complexity.UserEdge.Cursor == nil {
+			break
+		}
 
-type executableSchema struct {
-	schema     *ast.Schema
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
-}
+		return e.complexity.UserEdge.Cursor(childComplexity), true
 
-func (e *executableSchema) Schema() *ast.Schema {
-	if e.schema != nil {
-		return e.schema
-	}
-	return parsedSchema
-}
+	case "UserEdge.followedAt":
+		if e.complexity.UserEdge.FollowedAt == nil {
+			break
+		}
 
-func (e *executableSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, rawArgs map[string]any) (int, bool) {
-	ec := executionContext{nil, e, 0, 0, nil}
-	_ = ec
-	switch typeName + "." + field {
+		return e.complexity.UserEdge.FollowedAt(childComplexity), true
 
-	case "Mutation.upsertUser":
-		if e.complexity.Mutation.UpsertUser == nil {
+	case "UserEdge.node":
+		if e.complexity.UserEdge.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_upsertUser_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.UserEdge.Node(childComplexity), true
+
+	case "UserRating.comment":
+		if e.complexity.UserRating.Comment == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpsertUser(childComplexity, args["input"].(gqlmodel.UpsertUserInput)), true
+		return e.complexity.UserRating.Comment(childComplexity), true
 
-	case "Query.me":
-		if e.complexity.Query.Me == nil {
+	case "UserRating.createdAt":
+		if e.complexity.UserRating.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Query.Me(childComplexity), true
+		return e.complexity.UserRating.CreatedAt(childComplexity), true
 
-	case "User.avatar":
-		if e.complexity.User.Avatar == nil {
+	case "UserRating.eventId":
+		if e.complexity.UserRating.EventID == nil {
 			break
 		}
 
-		return e.complexity.User.Avatar(childComplexity), true
+		return e.complexity.UserRating.EventID(childComplexity), true
 
-	case "User.createdAt":
-		if e.complexity.User.CreatedAt == nil {
+	case "UserRating.id":
+		if e.complexity.UserRating.ID == nil {
 			break
 		}
 
-		return e.complexity.User.CreatedAt(childComplexity), true
+		return e.complexity.UserRating.ID(childComplexity), true
 
-	case "User.id":
-		if e.complexity.User.ID == nil {
+	case "UserRating.raterId":
+		if e.complexity.UserRating.RaterID == nil {
 			break
 		}
 
-		return e.complexity.User.ID(childComplexity), true
+		return e.complexity.UserRating.RaterID(childComplexity), true
 
-	case "User.nickname":
-		if e.complexity.User.Nickname == nil {
+	case "UserRating.score":
+		if e.complexity.UserRating.Score == nil {
 			break
 		}
 
-		return e.complexity.User.Nickname(childComplexity), true
+		return e.complexity.UserRating.Score(childComplexity), true
 
-	case "User.uid":
-		if e.complexity.User.UID == nil {
+	case "UserRating.targetUserId":
+		if e.complexity.UserRating.TargetUserID == nil {
 			break
 		}
 
-		return e.complexity.User.UID(childComplexity), true
+		return e.complexity.UserRating.TargetUserID(childComplexity), true
 
 	}
 	return 0, false
@@ -139,6 +2133,21 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	opCtx := graphql.GetOperationContext(ctx)
 	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputCreateApiKeyInput,
+		ec.unmarshalInputCreateEventInput,
+		ec.unmarshalInputCreateGroupInput,
+		ec.unmarshalInputCreateTenantInput,
+		ec.unmarshalInputEducationInput,
+		ec.unmarshalInputEmploymentInput,
+		ec.unmarshalInputEventFilter,
+		ec.unmarshalInputPostCommentInput,
+		ec.unmarshalInputRateEventInput,
+		ec.unmarshalInputRateParticipantInput,
+		ec.unmarshalInputSubmitCertificationInput,
+		ec.unmarshalInputUpdateEducationInput,
+		ec.unmarshalInputUpdateEmploymentInput,
+		ec.unmarshalInputUpdateEventInput,
+		ec.unmarshalInputUpdateGroupInput,
 		ec.unmarshalInputUpsertUserInput,
 	)
 	first := true
@@ -185,6 +2194,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -237,23 +2263,584 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "../schema/event/comment.graphql", Input: `type Comment {
+  id: ID!
+  eventId: ID!
+  userId: ID!
+  userNickname: String
+  content: String!
+  parentId: ID
+  depth: Int!
+  replies: [Comment!]!
+  createdAt: String
+}
+
+type CommentEdge {
+  cursor: String!
+  node: Comment!
+}
+
+type CommentConnection {
+  edges: [CommentEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+extend type Query {
+  comments(eventId: ID!, first: Int, after: String): CommentConnection!
+}
+
+extend type Mutation {
+  postComment(input: PostCommentInput!): Comment! @auth
+  deleteComment(id: ID!): Comment! @auth
+}
+
+extend type Subscription {
+  commentAdded(eventId: ID!): Comment!
+}
+
+input PostCommentInput {
+  eventId: ID!
+  content: String!
+  parentId: ID
+}
+`, BuiltIn: false},
+	{Name: "../schema/event/event.graphql", Input: `enum LocationType {
+  ONLINE
+  OFFLINE
+  HYBRID
+}
+
+type Event {
+  id: ID!
+  ownerId: ID!
+  ownerNickname: String
+  title: String!
+  description: String
+  primaryTag: String
+  secondaryTags: [String!]!
+  startTime: String!
+  endTime: String!
+  locationType: LocationType!
+  locationDetail: String
+  coverImage: String
+  requireApproval: Boolean!
+  participantLimit: Int
+  groupId: ID
+  tenantId: String
+  cancelledAt: String
+  cancelReason: String
+  createdAt: String
+}
+
+extend type Query {
+  event(id: ID!): Event
+  events(filter: EventFilter): [Event!]!
+}
+
+extend type Mutation {
+  createEvent(input: CreateEventInput!): Event! @auth
+  updateEvent(id: ID!, input: UpdateEventInput!): Event! @owner(resource: EVENT)
+  cancelEvent(id: ID!, reason: String): Event! @owner(resource: EVENT)
+}
+
+extend type Subscription {
+  # 活动信息、报名人数或状态变化时推送
+  eventUpdated(id: ID!): Event!
+}
+
+input EventFilter {
+  ownerId: ID
+  groupId: ID
+  primaryTag: String
+  locationType: LocationType
+  startsAfter: String
+  startsBefore: String
+  includeCancelled: Boolean
+  limit: Int
+  offset: Int
+}
+
+input CreateEventInput {
+  title: String!
+  description: String
+  primaryTag: String
+  secondaryTags: [String!]
+  startTime: String!
+  endTime: String!
+  locationType: LocationType!
+  locationDetail: String
+  coverImage: String
+  requireApproval: Boolean
+  participantLimit: Int
+}
+
+input UpdateEventInput {
+  title: String
+  description: String
+  primaryTag: String
+  secondaryTags: [String!]
+  startTime: String
+  endTime: String
+  locationType: LocationType
+  locationDetail: String
+  coverImage: String
+  requireApproval: Boolean
+  # 不能低于已通过的人数；调高后按候补顺序递补
+  participantLimit: Int
+  # 为 true 时取消名额限制，候补全部递补，不能与 participantLimit 同时使用
+  clearParticipantLimit: Boolean
+}
+`, BuiltIn: false},
+	{Name: "../schema/event/group.graphql", Input: `type EventGroup {
+  id: ID!
+  name: String!
+  ownerId: ID!
+  description: String
+  tags: [String!]!
+  pictures: [String!]!
+  isPublic: Boolean!
+  scoreAvg: Float
+  eventCount: Int!
+  totalParticipants: Int!
+  ratingCount: Int!
+  createdAt: String
+}
+
+extend type Query {
+  group(id: ID!): EventGroup
+  groups(limit: Int, offset: Int): [EventGroup!]!
+  mySubscribedGroupEvents(limit: Int, offset: Int): [Event!]! @auth
+}
+
+extend type Mutation {
+  createGroup(input: CreateGroupInput!): EventGroup! @auth
+  updateGroup(id: ID!, input: UpdateGroupInput!): EventGroup! @owner(resource: GROUP)
+  setEventGroup(eventId: ID!, groupId: ID): Event! @owner(resource: EVENT, idArg: "eventId")
+  subscribeGroup(id: ID!): EventGroup! @auth
+  unsubscribeGroup(id: ID!): Boolean! @auth
+}
+
+input CreateGroupInput {
+  name: String!
+  description: String
+  tags: [String!]
+  pictures: [String!]
+  isPublic: Boolean
+}
+
+input UpdateGroupInput {
+  name: String
+  description: String
+  tags: [String!]
+  pictures: [String!]
+  isPublic: Boolean
+}
+`, BuiltIn: false},
+	{Name: "../schema/event/participant.graphql", Input: `enum ParticipantStatus {
+  PENDING
+  APPROVED
+  REJECTED
+  WAITLISTED
+  ATTENDED
+  NO_SHOW
+}
+
+type EventParticipant {
+  id: ID!
+  eventId: ID!
+  userId: ID!
+  status: ParticipantStatus!
+  joinedAt: String
+  leftAt: String
+}
+
+extend type Event {
+  # 匿名用户和非发起人只能看到 approved 的报名者，其他状态仅对发起人可见
+  participants(status: ParticipantStatus): [EventParticipant!]!
+}
+
+extend type Mutation {
+  joinEvent(eventId: ID!): EventParticipant! @auth
+  leaveEvent(eventId: ID!): EventParticipant! @auth
+  approveParticipant(eventId: ID!, userId: ID!): EventParticipant! @owner(resource: EVENT, idArg: "eventId")
+  rejectParticipant(eventId: ID!, userId: ID!): EventParticipant! @owner(resource: EVENT, idArg: "eventId")
+  markAttendance(eventId: ID!, userId: ID!, attended: Boolean!): EventParticipant! @owner(resource: EVENT, idArg: "eventId")
+}
+`, BuiltIn: false},
+	{Name: "../schema/event/rating.graphql", Input: `type EventRating {
+  id: ID!
+  eventId: ID!
+  raterId: ID!
+  score: Int!
+  comment: String
+  createdAt: String
+}
+
+type UserRating {
+  id: ID!
+  eventId: ID!
+  raterId: ID!
+  targetUserId: ID!
+  score: Int!
+  comment: String
+  createdAt: String
+}
+
+extend type Mutation {
+  rateEvent(input: RateEventInput!): EventRating! @auth
+  rateParticipant(input: RateParticipantInput!): UserRating! @auth
+}
+
+input RateEventInput {
+  eventId: ID!
+  score: Int!
+  comment: String
+}
+
+input RateParticipantInput {
+  eventId: ID!
+  targetUserId: ID!
+  score: Int!
+  comment: String
+}
+`, BuiltIn: false},
+	{Name: "../schema/notification/notification.graphql", Input: `enum NotificationChannel {
+  IN_APP
+  EMAIL
+  SMS
+  PUSH
+}
+
+type Notification {
+  id: ID!
+  type: String!
+  # JSON 字符串，结构由 type 决定
+  content: String!
+  channel: NotificationChannel!
+  isRead: Boolean!
+  readAt: String
+  createdAt: String
+}
+
+type NotificationEdge {
+  cursor: String!
+  node: Notification!
+}
+
+type NotificationConnection {
+  edges: [NotificationEdge!]!
+  pageInfo: PageInfo!
+  unreadCount: Int!
+}
+
+extend type Query {
+  notifications(first: Int, after: String, unreadOnly: Boolean): NotificationConnection! @auth
+  unreadNotificationCount: Int! @auth
+}
+
+extend type Mutation {
+  markRead(id: ID!): Notification! @auth
+  # 返回标记为已读的条数
+  markAllRead: Int! @auth
+}
+
+extend type Subscription {
+  notificationAdded: Notification! @auth
+}
+`, BuiltIn: false},
 	{Name: "../schema/root.graphql", Input: `type Query
 type Mutation
+# 通过 WebSocket（graphql-ws）订阅，token 放在 connection_init 的 payload 中
+type Subscription
+
+# 需要登录
+directive @auth on FIELD_DEFINITION
+
+# 需要在租户中具备指定角色，租户取自参数 tenantArg，参数不存在时取请求的活跃租户；平台管理员不受限制
+directive @hasTenantRole(role: TenantRole!, tenantArg: String = "tenantId") on FIELD_DEFINITION
+
+# 需要是参数 idArg 指向的资源的发起人
+directive @owner(resource: OwnedResource!, idArg: String = "id") on FIELD_DEFINITION
+
+enum OwnedResource {
+  EVENT
+  GROUP
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  endCursor: String
+}
+`, BuiltIn: false},
+	{Name: "../schema/tenant/tenant.graphql", Input: `enum TenantRole {
+  ADMIN
+  MEMBER
+}
+
+type Tenant {
+  id: ID!
+  name: String!
+  region: String!
+  createdAt: String
+}
+
+type TenantMember {
+  tenantId: ID!
+  userId: ID!
+  role: TenantRole!
+  joinedAt: String
+}
+
+type TenantMembership {
+  tenant: Tenant!
+  role: TenantRole!
+  joinedAt: String
+}
+
+extend type Query {
+  myTenants: [TenantMembership!]! @auth
+  tenantMembers(tenantId: ID!): [TenantMember!]! @hasTenantRole(role: MEMBER)
+}
+
+extend type Mutation {
+  createTenant(input: CreateTenantInput!): Tenant! @auth
+  addTenantMember(tenantId: ID!, userId: ID!, role: TenantRole!): TenantMember! @hasTenantRole(role: ADMIN)
+  changeTenantMemberRole(tenantId: ID!, userId: ID!, role: TenantRole!): TenantMember! @hasTenantRole(role: ADMIN)
+  removeTenantMember(tenantId: ID!, userId: ID!): Boolean! @hasTenantRole(role: MEMBER)
+}
+
+input CreateTenantInput {
+  id: ID!
+  name: String!
+  region: String!
+}
+`, BuiltIn: false},
+	{Name: "../schema/user/apikey.graphql", Input: `# 服务端脚本访问 GraphQL 的凭据：Authorization: Bearer <key>
+# API key 以创建者的身份访问，不能用 API key 管理 API key
+
+enum ApiKeyScope {
+  # query 和 subscription
+  READ
+  # mutation
+  WRITE
+}
+
+type ApiKey {
+  id: ID!
+  name: String!
+  # key 的开头部分（dtk_xxxxxxxxxxxx），用于辨认
+  prefix: String!
+  # 绑定的租户，为空表示不限制
+  tenantId: ID
+  scopes: [ApiKeyScope!]!
+  expiresAt: String
+  lastUsedAt: String
+  revokedAt: String
+  createdAt: String
+}
+
+type CreateApiKeyPayload {
+  apiKey: ApiKey!
+  # 完整的 key，只在创建时返回一次
+  key: String!
+}
+
+input CreateApiKeyInput {
+  name: String!
+  scopes: [ApiKeyScope!]!
+  # 只能绑定自己所在的租户
+  tenantId: ID
+  # RFC3339，为空表示永不过期
+  expiresAt: String
+}
+
+extend type Query {
+  apiKeys: [ApiKey!]! @auth
+}
+
+extend type Mutation {
+  createApiKey(input: CreateApiKeyInput!): CreateApiKeyPayload! @auth
+  revokeApiKey(id: ID!): ApiKey! @auth
+}
+`, BuiltIn: false},
+	{Name: "../schema/user/certification.graphql", Input: `enum CertificationType {
+  EDUCATION
+  EMPLOYMENT
+  SKILL
+  OTHER
+}
+
+enum CertificationStatus {
+  PENDING
+  APPROVED
+  REJECTED
+}
+
+type Certification {
+  id: ID!
+  userId: ID!
+  certType: CertificationType!
+  status: CertificationStatus!
+  proof: String
+  educationId: ID
+  employmentId: ID
+  reviewerId: ID
+  reviewReason: String
+  reviewedAt: String
+  createdAt: String
+}
+
+extend type Query {
+  myCertifications: [Certification!]! @auth
+  # 仅平台管理员可用
+  certificationQueue(first: Int, after: ID): [Certification!]! @auth
+}
+
+extend type Mutation {
+  submitCertification(input: SubmitCertificationInput!): Certification! @auth
+  approveCertification(id: ID!, reason: String): Certification! @auth
+  rejectCertification(id: ID!, reason: String!): Certification! @auth
+}
+
+input SubmitCertificationInput {
+  certType: CertificationType!
+  proof: String!
+  educationId: ID
+  employmentId: ID
+}
+`, BuiltIn: false},
+	{Name: "../schema/user/follow.graphql", Input: `type UserEdge {
+  cursor: String!
+  node: User!
+  followedAt: String
+}
+
+type UserConnection {
+  edges: [UserEdge!]!
+  pageInfo: PageInfo!
+  totalCount: Int!
+}
+
+extend type User {
+  followerCount: Int!
+  followingCount: Int!
+  followers(first: Int, after: String): UserConnection!
+  following(first: Int, after: String): UserConnection!
+  # 未登录时为 false
+  isFollowedByMe: Boolean!
+  # 当前用户和该用户互相关注
+  isMutualFollow: Boolean!
+}
+
+extend type Mutation {
+  follow(userId: ID!): User! @auth
+  unfollow(userId: ID!): User! @auth
+}
+`, BuiltIn: false},
+	{Name: "../schema/user/profile.graphql", Input: `type Education {
+  id: ID!
+  userId: ID!
+  schoolName: String!
+  degree: String
+  major: String
+  # 只能由认证审核通过后置为 true
+  isVerified: Boolean!
+  sortOrder: Int!
+}
+
+type Employment {
+  id: ID!
+  userId: ID!
+  companyName: String!
+  jobTitle: String
+  # 只能由认证审核通过后置为 true
+  isVerified: Boolean!
+  sortOrder: Int!
+}
+
+extend type User {
+  educations: [Education!]!
+  employments: [Employment!]!
+}
+
+extend type Mutation {
+  addEducation(input: EducationInput!): Education! @auth
+  updateEducation(id: ID!, input: UpdateEducationInput!): Education! @auth
+  deleteEducation(id: ID!): Boolean! @auth
+  reorderEducations(ids: [ID!]!): [Education!]! @auth
+
+  addEmployment(input: EmploymentInput!): Employment! @auth
+  updateEmployment(id: ID!, input: UpdateEmploymentInput!): Employment! @auth
+  deleteEmployment(id: ID!): Boolean! @auth
+  reorderEmployments(ids: [ID!]!): [Employment!]! @auth
+}
+
+input EducationInput {
+  schoolName: String!
+  degree: String
+  major: String
+}
+
+input UpdateEducationInput {
+  schoolName: String
+  degree: String
+  major: String
+}
+
+input EmploymentInput {
+  companyName: String!
+  jobTitle: String
+}
+
+input UpdateEmploymentInput {
+  companyName: String
+  jobTitle: String
+}
+`, BuiltIn: false},
+	{Name: "../schema/user/session.graphql", Input: `# 登录会话，对应签发方 token 中的 sid（没有 sid 时为 jti），首次使用时登记
+# 撤销后该会话的 token 在过期前也会被拒绝
+
+type Session {
+  id: ID!
+  # token 的签发方
+  issuer: String!
+  userAgent: String
+  ipAddress: String
+  # 是否为当前请求使用的会话
+  current: Boolean!
+  createdAt: String
+  lastSeenAt: String
+  expiresAt: String
+}
+
+extend type Query {
+  mySessions: [Session!]! @auth
+}
+
+extend type Mutation {
+  revokeSession(id: ID!): Session! @auth
+  # 撤销全部会话（包括当前会话），之前签发的 token 都会失效；返回撤销的会话数
+  revokeAllSessions: Int! @auth
+}
 `, BuiltIn: false},
 	{Name: "../schema/user/user.graphql", Input: `type User {
   id: ID!
   uid: String!
   nickname: String
   avatar: String
+  ratingAvg: Float
+  ratingCount: Int
+  isPlatformAdmin: Boolean!
   createdAt: String
 }
 
 extend type Query {
-  me: User
+  me: User @auth
 }
 
 extend type Mutation {
-  upsertUser(input: UpsertUserInput!): User!
+  upsertUser(input: UpsertUserInput!): User! @auth
 }
 
 input UpsertUserInput {
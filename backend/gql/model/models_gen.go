@@ -2,12 +2,326 @@
 
 package gqlmodel
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type APIKey struct {
+	ID         string        `json:"id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"`
+	TenantID   *string       `json:"tenantId,omitempty"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	ExpiresAt  *string       `json:"expiresAt,omitempty"`
+	LastUsedAt *string       `json:"lastUsedAt,omitempty"`
+	RevokedAt  *string       `json:"revokedAt,omitempty"`
+	CreatedAt  *string       `json:"createdAt,omitempty"`
+}
+
+type Certification struct {
+	ID           string              `json:"id"`
+	UserID       string              `json:"userId"`
+	CertType     CertificationType   `json:"certType"`
+	Status       CertificationStatus `json:"status"`
+	Proof        *string             `json:"proof,omitempty"`
+	EducationID  *string             `json:"educationId,omitempty"`
+	EmploymentID *string             `json:"employmentId,omitempty"`
+	ReviewerID   *string             `json:"reviewerId,omitempty"`
+	ReviewReason *string             `json:"reviewReason,omitempty"`
+	ReviewedAt   *string             `json:"reviewedAt,omitempty"`
+	CreatedAt    *string             `json:"createdAt,omitempty"`
+}
+
+type Comment struct {
+	ID           string     `json:"id"`
+	EventID      string     `json:"eventId"`
+	UserID       string     `json:"userId"`
+	UserNickname *string    `json:"userNickname,omitempty"`
+	Content      string     `json:"content"`
+	ParentID     *string    `json:"parentId,omitempty"`
+	Depth        int32      `json:"depth"`
+	Replies      []*Comment `json:"replies"`
+	CreatedAt    *string    `json:"createdAt,omitempty"`
+}
+
+type CommentConnection struct {
+	Edges      []*CommentEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int32          `json:"totalCount"`
+}
+
+type CommentEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Comment `json:"node"`
+}
+
+type CreateAPIKeyInput struct {
+	Name      string        `json:"name"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	TenantID  *string       `json:"tenantId,omitempty"`
+	ExpiresAt *string       `json:"expiresAt,omitempty"`
+}
+
+type CreateAPIKeyPayload struct {
+	APIKey *APIKey `json:"apiKey"`
+	Key    string  `json:"key"`
+}
+
+type CreateEventInput struct {
+	Title            string       `json:"title"`
+	Description      *string      `json:"description,omitempty"`
+	PrimaryTag       *string      `json:"primaryTag,omitempty"`
+	SecondaryTags    []string     `json:"secondaryTags,omitempty"`
+	StartTime        string       `json:"startTime"`
+	EndTime          string       `json:"endTime"`
+	LocationType     LocationType `json:"locationType"`
+	LocationDetail   *string      `json:"locationDetail,omitempty"`
+	CoverImage       *string      `json:"coverImage,omitempty"`
+	RequireApproval  *bool        `json:"requireApproval,omitempty"`
+	ParticipantLimit *int32       `json:"participantLimit,omitempty"`
+}
+
+type CreateGroupInput struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Pictures    []string `json:"pictures,omitempty"`
+	IsPublic    *bool    `json:"isPublic,omitempty"`
+}
+
+type CreateTenantInput struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Region string `json:"region"`
+}
+
+type Education struct {
+	ID         string  `json:"id"`
+	UserID     string  `json:"userId"`
+	SchoolName string  `json:"schoolName"`
+	Degree     *string `json:"degree,omitempty"`
+	Major      *string `json:"major,omitempty"`
+	IsVerified bool    `json:"isVerified"`
+	SortOrder  int32   `json:"sortOrder"`
+}
+
+type EducationInput struct {
+	SchoolName string  `json:"schoolName"`
+	Degree     *string `json:"degree,omitempty"`
+	Major      *string `json:"major,omitempty"`
+}
+
+type Employment struct {
+	ID          string  `json:"id"`
+	UserID      string  `json:"userId"`
+	CompanyName string  `json:"companyName"`
+	JobTitle    *string `json:"jobTitle,omitempty"`
+	IsVerified  bool    `json:"isVerified"`
+	SortOrder   int32   `json:"sortOrder"`
+}
+
+type EmploymentInput struct {
+	CompanyName string  `json:"companyName"`
+	JobTitle    *string `json:"jobTitle,omitempty"`
+}
+
+type Event struct {
+	ID               string              `json:"id"`
+	OwnerID          string              `json:"ownerId"`
+	OwnerNickname    *string             `json:"ownerNickname,omitempty"`
+	Title            string              `json:"title"`
+	Description      *string             `json:"description,omitempty"`
+	PrimaryTag       *string             `json:"primaryTag,omitempty"`
+	SecondaryTags    []string            `json:"secondaryTags"`
+	StartTime        string              `json:"startTime"`
+	EndTime          string              `json:"endTime"`
+	LocationType     LocationType        `json:"locationType"`
+	LocationDetail   *string             `json:"locationDetail,omitempty"`
+	CoverImage       *string             `json:"coverImage,omitempty"`
+	RequireApproval  bool                `json:"requireApproval"`
+	ParticipantLimit *int32              `json:"participantLimit,omitempty"`
+	GroupID          *string             `json:"groupId,omitempty"`
+	TenantID         *string             `json:"tenantId,omitempty"`
+	CancelledAt      *string             `json:"cancelledAt,omitempty"`
+	CancelReason     *string             `json:"cancelReason,omitempty"`
+	CreatedAt        *string             `json:"createdAt,omitempty"`
+	Participants     []*EventParticipant `json:"participants"`
+}
+
+type EventFilter struct {
+	OwnerID          *string       `json:"ownerId,omitempty"`
+	GroupID          *string       `json:"groupId,omitempty"`
+	PrimaryTag       *string       `json:"primaryTag,omitempty"`
+	LocationType     *LocationType `json:"locationType,omitempty"`
+	StartsAfter      *string       `json:"startsAfter,omitempty"`
+	StartsBefore     *string       `json:"startsBefore,omitempty"`
+	IncludeCancelled *bool         `json:"includeCancelled,omitempty"`
+	Limit            *int32        `json:"limit,omitempty"`
+	Offset           *int32        `json:"offset,omitempty"`
+}
+
+type EventGroup struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	OwnerID           string   `json:"ownerId"`
+	Description       *string  `json:"description,omitempty"`
+	Tags              []string `json:"tags"`
+	Pictures          []string `json:"pictures"`
+	IsPublic          bool     `json:"isPublic"`
+	ScoreAvg          *float64 `json:"scoreAvg,omitempty"`
+	EventCount        int32    `json:"eventCount"`
+	TotalParticipants int32    `json:"totalParticipants"`
+	RatingCount       int32    `json:"ratingCount"`
+	CreatedAt         *string  `json:"createdAt,omitempty"`
+}
+
+type EventParticipant struct {
+	ID       string            `json:"id"`
+	EventID  string            `json:"eventId"`
+	UserID   string            `json:"userId"`
+	Status   ParticipantStatus `json:"status"`
+	JoinedAt *string           `json:"joinedAt,omitempty"`
+	LeftAt   *string           `json:"leftAt,omitempty"`
+}
+
+type EventRating struct {
+	ID        string  `json:"id"`
+	EventID   string  `json:"eventId"`
+	RaterID   string  `json:"raterId"`
+	Score     int32   `json:"score"`
+	Comment   *string `json:"comment,omitempty"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+}
+
 type Mutation struct {
 }
 
+type Notification struct {
+	ID        string              `json:"id"`
+	Type      string              `json:"type"`
+	Content   string              `json:"content"`
+	Channel   NotificationChannel `json:"channel"`
+	IsRead    bool                `json:"isRead"`
+	ReadAt    *string             `json:"readAt,omitempty"`
+	CreatedAt *string             `json:"createdAt,omitempty"`
+}
+
+type NotificationConnection struct {
+	Edges       []*NotificationEdge `json:"edges"`
+	PageInfo    *PageInfo           `json:"pageInfo"`
+	UnreadCount int32               `json:"unreadCount"`
+}
+
+type NotificationEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *Notification `json:"node"`
+}
+
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor,omitempty"`
+}
+
+type PostCommentInput struct {
+	EventID  string  `json:"eventId"`
+	Content  string  `json:"content"`
+	ParentID *string `json:"parentId,omitempty"`
+}
+
 type Query struct {
 }
 
+type RateEventInput struct {
+	EventID string  `json:"eventId"`
+	Score   int32   `json:"score"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+type RateParticipantInput struct {
+	EventID      string  `json:"eventId"`
+	TargetUserID string  `json:"targetUserId"`
+	Score        int32   `json:"score"`
+	Comment      *string `json:"comment,omitempty"`
+}
+
+type Session struct {
+	ID         string  `json:"id"`
+	Issuer     string  `json:"issuer"`
+	UserAgent  *string `json:"userAgent,omitempty"`
+	IPAddress  *string `json:"ipAddress,omitempty"`
+	Current    bool    `json:"current"`
+	CreatedAt  *string `json:"createdAt,omitempty"`
+	LastSeenAt *string `json:"lastSeenAt,omitempty"`
+	ExpiresAt  *string `json:"expiresAt,omitempty"`
+}
+
+type SubmitCertificationInput struct {
+	CertType     CertificationType `json:"certType"`
+	Proof        string            `json:"proof"`
+	EducationID  *string           `json:"educationId,omitempty"`
+	EmploymentID *string           `json:"employmentId,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type Tenant struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Region    string  `json:"region"`
+	CreatedAt *string `json:"createdAt,omitempty"`
+}
+
+type TenantMember struct {
+	TenantID string     `json:"tenantId"`
+	UserID   string     `json:"userId"`
+	Role     TenantRole `json:"role"`
+	JoinedAt *string    `json:"joinedAt,omitempty"`
+}
+
+type TenantMembership struct {
+	Tenant   *Tenant    `json:"tenant"`
+	Role     TenantRole `json:"role"`
+	JoinedAt *string    `json:"joinedAt,omitempty"`
+}
+
+type UpdateEducationInput struct {
+	SchoolName *string `json:"schoolName,omitempty"`
+	Degree     *string `json:"degree,omitempty"`
+	Major      *string `json:"major,omitempty"`
+}
+
+type UpdateEmploymentInput struct {
+	CompanyName *string `json:"companyName,omitempty"`
+	JobTitle    *string `json:"jobTitle,omitempty"`
+}
+
+type UpdateEventInput struct {
+	Title                 *string       `json:"title,omitempty"`
+	Description           *string       `json:"description,omitempty"`
+	PrimaryTag            *string       `json:"primaryTag,omitempty"`
+	SecondaryTags         []string      `json:"secondaryTags,omitempty"`
+	StartTime             *string       `json:"startTime,omitempty"`
+	EndTime               *string       `json:"endTime,omitempty"`
+	LocationType          *LocationType `json:"locationType,omitempty"`
+	LocationDetail        *string       `json:"locationDetail,omitempty"`
+	CoverImage            *string       `json:"coverImage,omitempty"`
+	RequireApproval       *bool         `json:"requireApproval,omitempty"`
+	ParticipantLimit      *int32        `json:"participantLimit,omitempty"`
+	ClearParticipantLimit *bool         `json:"clearParticipantLimit,omitempty"`
+}
+
+type UpdateGroupInput struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Pictures    []string `json:"pictures,omitempty"`
+	IsPublic    *bool    `json:"isPublic,omitempty"`
+}
+
 type UpsertUserInput struct {
 	UID      string  `json:"uid"`
 	Nickname *string `json:"nickname,omitempty"`
@@ -15,9 +329,502 @@ type UpsertUserInput struct {
 }
 
 type User struct {
-	ID        string  `json:"id"`
-	UID       string  `json:"uid"`
-	Nickname  *string `json:"nickname,omitempty"`
-	Avatar    *string `json:"avatar,omitempty"`
-	CreatedAt *string `json:"createdAt,omitempty"`
+	ID              string          `json:"id"`
+	UID             string          `json:"uid"`
+	Nickname        *string         `json:"nickname,omitempty"`
+	Avatar          *string         `json:"avatar,omitempty"`
+	RatingAvg       *float64        `json:"ratingAvg,omitempty"`
+	RatingCount     *int32          `json:"ratingCount,omitempty"`
+	IsPlatformAdmin bool            `json:"isPlatformAdmin"`
+	CreatedAt       *string         `json:"createdAt,omitempty"`
+	FollowerCount   int32           `json:"followerCount"`
+	FollowingCount  int32           `json:"followingCount"`
+	Followers       *UserConnection `json:"followers"`
+	Following       *UserConnection `json:"following"`
+	IsFollowedByMe  bool            `json:"isFollowedByMe"`
+	IsMutualFollow  bool            `json:"isMutualFollow"`
+	Educations      []*Education    `json:"educations"`
+	Employments     []*Employment   `json:"employments"`
+}
+
+type UserConnection struct {
+	Edges      []*UserEdge `json:"edges"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	TotalCount int32       `json:"totalCount"`
+}
+
+type UserEdge struct {
+	Cursor     string  `json:"cursor"`
+	Node       *User   `json:"node"`
+	FollowedAt *string `json:"followedAt,omitempty"`
+}
+
+type UserRating struct {
+	ID           string  `json:"id"`
+	EventID      string  `json:"eventId"`
+	RaterID      string  `json:"raterId"`
+	TargetUserID string  `json:"targetUserId"`
+	Score        int32   `json:"score"`
+	Comment      *string `json:"comment,omitempty"`
+	CreatedAt    *string `json:"createdAt,omitempty"`
+}
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeRead  APIKeyScope = "READ"
+	APIKeyScopeWrite APIKeyScope = "WRITE"
+)
+
+var AllAPIKeyScope = []APIKeyScope{
+	APIKeyScopeRead,
+	APIKeyScopeWrite,
+}
+
+func (e APIKeyScope) IsValid() bool {
+	switch e {
+	case APIKeyScopeRead, APIKeyScopeWrite:
+		return true
+	}
+	return false
+}
+
+func (e APIKeyScope) String() string {
+	return string(e)
+}
+
+func (e *APIKeyScope) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIKeyScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApiKeyScope", str)
+	}
+	return nil
+}
+
+func (e APIKeyScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *APIKeyScope) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e APIKeyScope) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CertificationStatus string
+
+const (
+	CertificationStatusPending  CertificationStatus = "PENDING"
+	CertificationStatusApproved CertificationStatus = "APPROVED"
+	CertificationStatusRejected CertificationStatus = "REJECTED"
+)
+
+var AllCertificationStatus = []CertificationStatus{
+	CertificationStatusPending,
+	CertificationStatusApproved,
+	CertificationStatusRejected,
+}
+
+func (e CertificationStatus) IsValid() bool {
+	switch e {
+	case CertificationStatusPending, CertificationStatusApproved, CertificationStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e CertificationStatus) String() string {
+	return string(e)
+}
+
+func (e *CertificationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CertificationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CertificationStatus", str)
+	}
+	return nil
+}
+
+func (e CertificationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CertificationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CertificationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CertificationType string
+
+const (
+	CertificationTypeEducation  CertificationType = "EDUCATION"
+	CertificationTypeEmployment CertificationType = "EMPLOYMENT"
+	CertificationTypeSkill      CertificationType = "SKILL"
+	CertificationTypeOther      CertificationType = "OTHER"
+)
+
+var AllCertificationType = []CertificationType{
+	CertificationTypeEducation,
+	CertificationTypeEmployment,
+	CertificationTypeSkill,
+	CertificationTypeOther,
+}
+
+func (e CertificationType) IsValid() bool {
+	switch e {
+	case CertificationTypeEducation, CertificationTypeEmployment, CertificationTypeSkill, CertificationTypeOther:
+		return true
+	}
+	return false
+}
+
+func (e CertificationType) String() string {
+	return string(e)
+}
+
+func (e *CertificationType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CertificationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CertificationType", str)
+	}
+	return nil
+}
+
+func (e CertificationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CertificationType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CertificationType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LocationType string
+
+const (
+	LocationTypeOnline  LocationType = "ONLINE"
+	LocationTypeOffline LocationType = "OFFLINE"
+	LocationTypeHybrid  LocationType = "HYBRID"
+)
+
+var AllLocationType = []LocationType{
+	LocationTypeOnline,
+	LocationTypeOffline,
+	LocationTypeHybrid,
+}
+
+func (e LocationType) IsValid() bool {
+	switch e {
+	case LocationTypeOnline, LocationTypeOffline, LocationTypeHybrid:
+		return true
+	}
+	return false
+}
+
+func (e LocationType) String() string {
+	return string(e)
+}
+
+func (e *LocationType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LocationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LocationType", str)
+	}
+	return nil
+}
+
+func (e LocationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LocationType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LocationType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationChannel string
+
+const (
+	NotificationChannelInApp NotificationChannel = "IN_APP"
+	NotificationChannelEmail NotificationChannel = "EMAIL"
+	NotificationChannelSms   NotificationChannel = "SMS"
+	NotificationChannelPush  NotificationChannel = "PUSH"
+)
+
+var AllNotificationChannel = []NotificationChannel{
+	NotificationChannelInApp,
+	NotificationChannelEmail,
+	NotificationChannelSms,
+	NotificationChannelPush,
+}
+
+func (e NotificationChannel) IsValid() bool {
+	switch e {
+	case NotificationChannelInApp, NotificationChannelEmail, NotificationChannelSms, NotificationChannelPush:
+		return true
+	}
+	return false
+}
+
+func (e NotificationChannel) String() string {
+	return string(e)
+}
+
+func (e *NotificationChannel) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationChannel", str)
+	}
+	return nil
+}
+
+func (e NotificationChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationChannel) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationChannel) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OwnedResource string
+
+const (
+	OwnedResourceEvent OwnedResource = "EVENT"
+	OwnedResourceGroup OwnedResource = "GROUP"
+)
+
+var AllOwnedResource = []OwnedResource{
+	OwnedResourceEvent,
+	OwnedResourceGroup,
+}
+
+func (e OwnedResource) IsValid() bool {
+	switch e {
+	case OwnedResourceEvent, OwnedResourceGroup:
+		return true
+	}
+	return false
+}
+
+func (e OwnedResource) String() string {
+	return string(e)
+}
+
+func (e *OwnedResource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OwnedResource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OwnedResource", str)
+	}
+	return nil
+}
+
+func (e OwnedResource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OwnedResource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OwnedResource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ParticipantStatus string
+
+const (
+	ParticipantStatusPending    ParticipantStatus = "PENDING"
+	ParticipantStatusApproved   ParticipantStatus = "APPROVED"
+	ParticipantStatusRejected   ParticipantStatus = "REJECTED"
+	ParticipantStatusWaitlisted ParticipantStatus = "WAITLISTED"
+	ParticipantStatusAttended   ParticipantStatus = "ATTENDED"
+	ParticipantStatusNoShow     ParticipantStatus = "NO_SHOW"
+)
+
+var AllParticipantStatus = []ParticipantStatus{
+	ParticipantStatusPending,
+	ParticipantStatusApproved,
+	ParticipantStatusRejected,
+	ParticipantStatusWaitlisted,
+	ParticipantStatusAttended,
+	ParticipantStatusNoShow,
+}
+
+func (e ParticipantStatus) IsValid() bool {
+	switch e {
+	case ParticipantStatusPending, ParticipantStatusApproved, ParticipantStatusRejected, ParticipantStatusWaitlisted, ParticipantStatusAttended, ParticipantStatusNoShow:
+		return true
+	}
+	return false
+}
+
+func (e ParticipantStatus) String() string {
+	return string(e)
+}
+
+func (e *ParticipantStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ParticipantStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ParticipantStatus", str)
+	}
+	return nil
+}
+
+func (e ParticipantStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ParticipantStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ParticipantStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TenantRole string
+
+const (
+	TenantRoleAdmin  TenantRole = "ADMIN"
+	TenantRoleMember TenantRole = "MEMBER"
+)
+
+var AllTenantRole = []TenantRole{
+	TenantRoleAdmin,
+	TenantRoleMember,
+}
+
+func (e TenantRole) IsValid() bool {
+	switch e {
+	case TenantRoleAdmin, TenantRoleMember:
+		return true
+	}
+	return false
+}
+
+func (e TenantRole) String() string {
+	return string(e)
+}
+
+func (e *TenantRole) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantRole", str)
+	}
+	return nil
+}
+
+func (e TenantRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TenantRole) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TenantRole) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
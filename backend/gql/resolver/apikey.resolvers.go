@@ -0,0 +1,85 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"slices"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// CreateAPIKey is the resolver for the createApiKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input gqlmodel.CreateAPIKeyInput) (*gqlmodel.CreateAPIKeyPayload, error) {
+	userID, err := jwtUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := parseOptionalTime(input.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	tenantID := stringValue(input.TenantID)
+	if tenantID != "" {
+		tenantIDs, err := r.TenantService.TenantIDsForUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(tenantIDs, tenantID) {
+			return nil, middleware.ErrNotTenantMember
+		}
+	}
+
+	scopes := make([]string, 0, len(input.Scopes))
+	for _, scope := range input.Scopes {
+		scopes = append(scopes, toAPIKeyScopeValue(scope))
+	}
+	created, key, err := r.UserService.CreateAPIKey(ctx, userID, user.CreateAPIKeyInput{
+		Name:      input.Name,
+		Scopes:    scopes,
+		TenantID:  tenantID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.CreateAPIKeyPayload{APIKey: toAPIKey(created), Key: key}, nil
+}
+
+// RevokeAPIKey is the resolver for the revokeApiKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, id string) (*gqlmodel.APIKey, error) {
+	userID, err := jwtUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.RevokeAPIKey(ctx, userID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIKey(result), nil
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *queryResolver) APIKeys(ctx context.Context) ([]*gqlmodel.APIKey, error) {
+	userID, err := jwtUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := r.UserService.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toAPIKeys(keys), nil
+}
@@ -0,0 +1,113 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// SubmitCertification is the resolver for the submitCertification field.
+func (r *mutationResolver) SubmitCertification(ctx context.Context, input gqlmodel.SubmitCertificationInput) (*gqlmodel.Certification, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	educationID, err := parseOptionalID(input.EducationID)
+	if err != nil {
+		return nil, err
+	}
+	employmentID, err := parseOptionalID(input.EmploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.SubmitCertification(ctx, userID, user.SubmitCertificationInput{
+		CertType:     toCertTypeValue(input.CertType),
+		Proof:        input.Proof,
+		EducationID:  educationID,
+		EmploymentID: employmentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toCertification(result), nil
+}
+
+// ApproveCertification is the resolver for the approveCertification field.
+func (r *mutationResolver) ApproveCertification(ctx context.Context, id string, reason *string) (*gqlmodel.Certification, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	certID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.ApproveCertification(ctx, certID, userID, stringValue(reason))
+	if err != nil {
+		return nil, err
+	}
+	return toCertification(result), nil
+}
+
+// RejectCertification is the resolver for the rejectCertification field.
+func (r *mutationResolver) RejectCertification(ctx context.Context, id string, reason string) (*gqlmodel.Certification, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	certID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.RejectCertification(ctx, certID, userID, reason)
+	if err != nil {
+		return nil, err
+	}
+	return toCertification(result), nil
+}
+
+// MyCertifications is the resolver for the myCertifications field.
+func (r *queryResolver) MyCertifications(ctx context.Context) ([]*gqlmodel.Certification, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	results, err := r.UserService.ListMyCertifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toCertifications(results), nil
+}
+
+// CertificationQueue is the resolver for the certificationQueue field.
+func (r *queryResolver) CertificationQueue(ctx context.Context, first *int32, after *string) ([]*gqlmodel.Certification, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	afterID, err := parseOptionalID(after)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.UserService.CertificationQueue(ctx, userID, int32Value(first), afterID)
+	if err != nil {
+		return nil, err
+	}
+	return toCertifications(results), nil
+}
@@ -0,0 +1,111 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+
+	eventdb "github.com/shiqi/datai/backend/db/events"
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// PostComment is the resolver for the postComment field.
+func (r *mutationResolver) PostComment(ctx context.Context, input gqlmodel.PostCommentInput) (*gqlmodel.Comment, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eventID, err := parseID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := parseOptionalID(input.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.PostComment(ctx, event.PostCommentInput{
+		EventID:  eventID,
+		UID:      userID,
+		Content:  input.Content,
+		ParentID: parentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toComment(&event.CommentNode{Comment: *result, Replies: []*event.CommentNode{}}), nil
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (*gqlmodel.Comment, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	commentID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.DeleteComment(ctx, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toComment(&event.CommentNode{Comment: *result, Replies: []*event.CommentNode{}}), nil
+}
+
+// Comments is the resolver for the comments field.
+func (r *queryResolver) Comments(ctx context.Context, eventID string, first *int32, after *string) (*gqlmodel.CommentConnection, error) {
+	id, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	var limit int32
+	if first != nil {
+		limit = *first
+	}
+
+	page, err := r.EventService.ListComments(ctx, id, limit, stringValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &gqlmodel.CommentConnection{
+		Edges:      make([]*gqlmodel.CommentEdge, 0, len(page.Nodes)),
+		PageInfo:   &gqlmodel.PageInfo{HasNextPage: page.HasNextPage},
+		TotalCount: int32(page.TotalCount),
+	}
+	if page.EndCursor != "" {
+		conn.PageInfo.EndCursor = &page.EndCursor
+	}
+	for _, node := range page.Nodes {
+		conn.Edges = append(conn.Edges, &gqlmodel.CommentEdge{
+			Cursor: event.CommentCursor(node.Comment),
+			Node:   toComment(node),
+		})
+	}
+	return conn, nil
+}
+
+// CommentAdded is the resolver for the commentAdded field.
+func (r *subscriptionResolver) CommentAdded(ctx context.Context, eventID string) (<-chan *gqlmodel.Comment, error) {
+	id, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := r.EventService.SubscribeComments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertChan(ctx, comments, func(c *eventdb.EventComment) *gqlmodel.Comment {
+		return toComment(&event.CommentNode{Comment: *c, Replies: []*event.CommentNode{}})
+	}), nil
+}
@@ -0,0 +1,457 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+	logdb "github.com/shiqi/datai/backend/db/log"
+	tenantdb "github.com/shiqi/datai/backend/db/tenant"
+	userdb "github.com/shiqi/datai/backend/db/user"
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/notification"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// 数据库模型与 GraphQL 模型之间的转换函数
+
+func parseID(id string) (int64, error) {
+	v, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", id)
+	}
+	return v, nil
+}
+
+func parseOptionalID(id *string) (*int64, error) {
+	if id == nil {
+		return nil, nil
+	}
+	v, err := parseID(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+func parseIDs(ids []string) ([]int64, error) {
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		v, err := parseID(id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected RFC3339", value)
+	}
+	return t, nil
+}
+
+func parseOptionalTime(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	t, err := parseTime(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func formatTime(t pgtype.Timestamptz) string {
+	return t.Time.UTC().Format(time.RFC3339)
+}
+
+func optionalTime(t pgtype.Timestamptz) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := formatTime(t)
+	return &s
+}
+
+func optionalText(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+func optionalInt4(v pgtype.Int4) *int32 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int32
+}
+
+func optionalIDInt8(v pgtype.Int8) *string {
+	if !v.Valid {
+		return nil
+	}
+	s := formatID(v.Int64)
+	return &s
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func int32Value(v *int32) int32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// convertChan 把 service 推送的数据逐条转换为 GraphQL 模型，in 关闭或 ctx 结束时关闭返回的 channel
+func convertChan[S, T any](ctx context.Context, in <-chan S, convert func(S) T) <-chan T {
+	out := make(chan T, 1)
+	go func() {
+		defer close(out)
+		for v := range in {
+			select {
+			case out <- convert(v):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func toLocationType(v gqlmodel.LocationType) string {
+	return strings.ToLower(string(v))
+}
+
+func toEvent(e *eventdb.Event) *gqlmodel.Event {
+	return &gqlmodel.Event{
+		ID:               formatID(e.ID),
+		OwnerID:          formatID(e.OwnerID),
+		OwnerNickname:    optionalText(e.OwnerNickname),
+		Title:            e.Title,
+		Description:      optionalText(e.Description),
+		PrimaryTag:       optionalText(e.PrimaryTag),
+		SecondaryTags:    event.DecodeTags(e.SecondaryTags),
+		StartTime:        formatTime(e.StartTime),
+		EndTime:          formatTime(e.EndTime),
+		LocationType:     gqlmodel.LocationType(strings.ToUpper(e.LocationType)),
+		LocationDetail:   optionalText(e.LocationDetail),
+		CoverImage:       optionalText(e.CoverImage),
+		RequireApproval:  e.RequireApproval.Valid && e.RequireApproval.Bool,
+		ParticipantLimit: optionalInt4(e.ParticipantLimit),
+		GroupID:          optionalIDInt8(e.GroupID),
+		TenantID:         optionalText(e.TenantID),
+		CancelledAt:      optionalTime(e.CancelledAt),
+		CancelReason:     optionalText(e.CancelReason),
+		CreatedAt:        optionalTime(e.CreatedAt),
+	}
+}
+
+func toEvents(events []eventdb.Event) []*gqlmodel.Event {
+	result := make([]*gqlmodel.Event, 0, len(events))
+	for i := range events {
+		result = append(result, toEvent(&events[i]))
+	}
+	return result
+}
+
+func toEventGroup(g *eventdb.EventGroup) *gqlmodel.EventGroup {
+	group := &gqlmodel.EventGroup{
+		ID:                formatID(g.ID),
+		Name:              g.Name,
+		OwnerID:           formatID(g.OwnerID),
+		Description:       optionalText(g.Description),
+		Tags:              event.DecodeTags(g.Tags),
+		Pictures:          event.DecodeTags(g.Pictures),
+		IsPublic:          g.IsPublic.Bool,
+		EventCount:        g.EventCount.Int32,
+		TotalParticipants: g.TotalParticipants.Int32,
+		RatingCount:       g.RatingCount.Int32,
+		CreatedAt:         optionalTime(g.CreatedAt),
+	}
+	if avg, err := g.ScoreAvg.Float64Value(); err == nil && avg.Valid {
+		group.ScoreAvg = &avg.Float64
+	}
+	return group
+}
+
+func toParticipantStatusValue(v gqlmodel.ParticipantStatus) string {
+	return strings.ToLower(string(v))
+}
+
+func toEventParticipant(p *eventdb.EventParticipant) *gqlmodel.EventParticipant {
+	return &gqlmodel.EventParticipant{
+		ID:       formatID(p.ID),
+		EventID:  formatID(p.EventID),
+		UserID:   formatID(p.UserID),
+		Status:   gqlmodel.ParticipantStatus(strings.ToUpper(p.Status.String)),
+		JoinedAt: optionalTime(p.JoinedAt),
+		LeftAt:   optionalTime(p.LeftAt),
+	}
+}
+
+func toComment(node *event.CommentNode) *gqlmodel.Comment {
+	c := node.Comment
+	comment := &gqlmodel.Comment{
+		ID:           formatID(c.ID),
+		EventID:      formatID(c.EventID),
+		UserID:       formatID(c.UserID),
+		UserNickname: optionalText(c.UserNickname),
+		Content:      c.Content,
+		ParentID:     optionalIDInt8(c.ParentID),
+		Depth:        node.Depth,
+		Replies:      make([]*gqlmodel.Comment, 0, len(node.Replies)),
+		CreatedAt:    optionalTime(c.CreatedAt),
+	}
+	for _, reply := range node.Replies {
+		comment.Replies = append(comment.Replies, toComment(reply))
+	}
+	return comment
+}
+
+func toTenant(t *tenantdb.Tenant) *gqlmodel.Tenant {
+	return &gqlmodel.Tenant{
+		ID:        t.ID,
+		Name:      t.Name,
+		Region:    t.Region,
+		CreatedAt: optionalTime(t.CreatedAt),
+	}
+}
+
+func toTenantRole(role string) gqlmodel.TenantRole {
+	return gqlmodel.TenantRole(strings.ToUpper(role))
+}
+
+func toTenantRoleValue(role gqlmodel.TenantRole) string {
+	return strings.ToLower(string(role))
+}
+
+func toTenantMember(m *tenantdb.UserTenant) *gqlmodel.TenantMember {
+	return &gqlmodel.TenantMember{
+		TenantID: m.TenantID,
+		UserID:   formatID(m.UserID),
+		Role:     toTenantRole(m.Role),
+		JoinedAt: optionalTime(m.JoinedAt),
+	}
+}
+
+func toUser(u *userdb.User) *gqlmodel.User {
+	nickname := ""
+	if u.Nickname.Valid {
+		nickname = u.Nickname.String
+	}
+	avatar := ""
+	if u.Avatar.Valid {
+		avatar = u.Avatar.String
+	}
+	createdAt := ""
+	if u.CreatedAt.Valid {
+		createdAt = formatTime(u.CreatedAt)
+	}
+
+	result := &gqlmodel.User{
+		ID:              formatID(u.ID),
+		UID:             u.Uid,
+		Nickname:        &nickname,
+		Avatar:          &avatar,
+		CreatedAt:       &createdAt,
+		IsPlatformAdmin: u.IsPlatformAdmin,
+	}
+	if avg, err := u.RatingAvg.Float64Value(); err == nil && avg.Valid {
+		result.RatingAvg = &avg.Float64
+	}
+	if u.RatingCount.Valid {
+		result.RatingCount = &u.RatingCount.Int32
+	}
+	return result
+}
+
+func toUserConnection(page *user.FollowPage, total int32) *gqlmodel.UserConnection {
+	conn := &gqlmodel.UserConnection{
+		Edges:      make([]*gqlmodel.UserEdge, 0, len(page.Edges)),
+		PageInfo:   &gqlmodel.PageInfo{HasNextPage: page.HasNextPage},
+		TotalCount: total,
+	}
+	if page.EndCursor != "" {
+		conn.PageInfo.EndCursor = &page.EndCursor
+	}
+	for i := range page.Edges {
+		edge := &page.Edges[i]
+		conn.Edges = append(conn.Edges, &gqlmodel.UserEdge{
+			Cursor:     edge.Cursor,
+			Node:       toUser(&edge.User),
+			FollowedAt: optionalTime(edge.FollowedAt),
+		})
+	}
+	return conn
+}
+
+func toEducation(e *userdb.UserEducation) *gqlmodel.Education {
+	return &gqlmodel.Education{
+		ID:         formatID(e.ID),
+		UserID:     formatID(e.UserID),
+		SchoolName: e.SchoolName,
+		Degree:     optionalText(e.Degree),
+		Major:      optionalText(e.Major),
+		IsVerified: e.IsVerified.Bool,
+		SortOrder:  e.SortOrder,
+	}
+}
+
+func toEducations(educations []userdb.UserEducation) []*gqlmodel.Education {
+	result := make([]*gqlmodel.Education, 0, len(educations))
+	for i := range educations {
+		result = append(result, toEducation(&educations[i]))
+	}
+	return result
+}
+
+func toEmployment(e *userdb.UserEmployment) *gqlmodel.Employment {
+	return &gqlmodel.Employment{
+		ID:          formatID(e.ID),
+		UserID:      formatID(e.UserID),
+		CompanyName: e.CompanyName,
+		JobTitle:    optionalText(e.JobTitle),
+		IsVerified:  e.IsVerified.Bool,
+		SortOrder:   e.SortOrder,
+	}
+}
+
+func toEmployments(employments []userdb.UserEmployment) []*gqlmodel.Employment {
+	result := make([]*gqlmodel.Employment, 0, len(employments))
+	for i := range employments {
+		result = append(result, toEmployment(&employments[i]))
+	}
+	return result
+}
+
+func toCertTypeValue(v gqlmodel.CertificationType) string {
+	return strings.ToLower(string(v))
+}
+
+func toCertification(c *userdb.UserCertification) *gqlmodel.Certification {
+	return &gqlmodel.Certification{
+		ID:           formatID(c.ID),
+		UserID:       formatID(c.UserID),
+		CertType:     gqlmodel.CertificationType(strings.ToUpper(c.CertType)),
+		Status:       gqlmodel.CertificationStatus(strings.ToUpper(c.Status.String)),
+		Proof:        optionalText(c.Proof),
+		EducationID:  optionalIDInt8(c.EducationID),
+		EmploymentID: optionalIDInt8(c.EmploymentID),
+		ReviewerID:   optionalIDInt8(c.ReviewerID),
+		ReviewReason: optionalText(c.ReviewReason),
+		ReviewedAt:   optionalTime(c.ReviewedAt),
+		CreatedAt:    optionalTime(c.CreatedAt),
+	}
+}
+
+func toCertifications(certs []userdb.UserCertification) []*gqlmodel.Certification {
+	result := make([]*gqlmodel.Certification, 0, len(certs))
+	for i := range certs {
+		result = append(result, toCertification(&certs[i]))
+	}
+	return result
+}
+
+func toAPIKeyScopeValue(v gqlmodel.APIKeyScope) string {
+	return strings.ToLower(string(v))
+}
+
+func toAPIKey(k *userdb.ApiKey) *gqlmodel.APIKey {
+	scopes := make([]gqlmodel.APIKeyScope, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, gqlmodel.APIKeyScope(strings.ToUpper(scope)))
+	}
+	return &gqlmodel.APIKey{
+		ID:         formatID(k.ID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		TenantID:   optionalText(k.TenantID),
+		Scopes:     scopes,
+		ExpiresAt:  optionalTime(k.ExpiresAt),
+		LastUsedAt: optionalTime(k.LastUsedAt),
+		RevokedAt:  optionalTime(k.RevokedAt),
+		CreatedAt:  optionalTime(k.CreatedAt),
+	}
+}
+
+func toAPIKeys(keys []userdb.ApiKey) []*gqlmodel.APIKey {
+	result := make([]*gqlmodel.APIKey, 0, len(keys))
+	for i := range keys {
+		result = append(result, toAPIKey(&keys[i]))
+	}
+	return result
+}
+
+// toSession current 为当前请求使用的会话，可以为 nil
+func toSession(s *userdb.Session, current *middleware.Session) *gqlmodel.Session {
+	return &gqlmodel.Session{
+		ID:         formatID(s.ID),
+		Issuer:     s.Issuer,
+		UserAgent:  optionalText(s.UserAgent),
+		IPAddress:  optionalText(s.IpAddress),
+		Current:    current != nil && current.Issuer == s.Issuer && current.ID == s.SessionKey,
+		CreatedAt:  optionalTime(s.CreatedAt),
+		LastSeenAt: optionalTime(s.LastSeenAt),
+		ExpiresAt:  optionalTime(s.ExpiresAt),
+	}
+}
+
+func toSessions(sessions []userdb.Session, current *middleware.Session) []*gqlmodel.Session {
+	result := make([]*gqlmodel.Session, 0, len(sessions))
+	for i := range sessions {
+		result = append(result, toSession(&sessions[i], current))
+	}
+	return result
+}
+
+func toNotification(n *logdb.Notification) *gqlmodel.Notification {
+	return &gqlmodel.Notification{
+		ID:        formatID(n.ID),
+		Type:      n.Type,
+		Content:   n.Content,
+		Channel:   gqlmodel.NotificationChannel(strings.ToUpper(n.ChannelType)),
+		IsRead:    n.IsRead,
+		ReadAt:    optionalTime(n.ReadAt),
+		CreatedAt: optionalTime(n.CreatedAt),
+	}
+}
+
+func toNotificationConnection(page *notification.Page) *gqlmodel.NotificationConnection {
+	conn := &gqlmodel.NotificationConnection{
+		Edges:       make([]*gqlmodel.NotificationEdge, 0, len(page.Notifications)),
+		PageInfo:    &gqlmodel.PageInfo{HasNextPage: page.HasNextPage},
+		UnreadCount: int32(page.UnreadCount),
+	}
+	if page.EndCursor != "" {
+		conn.PageInfo.EndCursor = &page.EndCursor
+	}
+	for i := range page.Notifications {
+		n := &page.Notifications[i]
+		conn.Edges = append(conn.Edges, &gqlmodel.NotificationEdge{
+			Cursor: notification.Cursor(*n),
+			Node:   toNotification(n),
+		})
+	}
+	return conn
+}
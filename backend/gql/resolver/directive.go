@@ -0,0 +1,112 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	gqlgenerated "github.com/shiqi/datai/backend/gql/generated"
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// schema 指令的实现，访问规则写在 .graphql 文件里，在这里统一校验
+
+var errForbidden = errors.New("forbidden")
+
+// Directives 返回注册给 gqlgen 的指令实现
+func (r *Resolver) Directives() gqlgenerated.DirectiveRoot {
+	return gqlgenerated.DirectiveRoot{
+		Auth:          r.authDirective,
+		HasTenantRole: r.hasTenantRoleDirective,
+		Owner:         r.ownerDirective,
+	}
+}
+
+func (r *Resolver) authDirective(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	if _, err := middleware.GetUserIDFromContext(ctx); err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+	return next(ctx)
+}
+
+func (r *Resolver) hasTenantRoleDirective(ctx context.Context, obj any, next graphql.Resolver, role gqlmodel.TenantRole, tenantArg *string) (any, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	tenantID, _ := fieldArg(ctx, tenantArg)
+	if tenantID == "" {
+		tenantID, _ = middleware.GetActiveTenantFromContext(ctx)
+	}
+	if tenantID == "" {
+		return nil, fmt.Errorf("%w: no tenant selected", errForbidden)
+	}
+
+	ok, err := r.TenantService.HasRole(ctx, tenantID, userID, toTenantRoleValue(role))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: requires tenant role %s", errForbidden, role)
+	}
+	return next(ctx)
+}
+
+func (r *Resolver) ownerDirective(ctx context.Context, obj any, next graphql.Resolver, resource gqlmodel.OwnedResource, idArg *string) (any, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	raw, ok := fieldArg(ctx, idArg)
+	if !ok {
+		return nil, fmt.Errorf("@owner: argument %q not found", stringValue(idArg))
+	}
+	id, err := parseID(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resource {
+	case gqlmodel.OwnedResourceEvent:
+		err = r.EventService.CheckEventOwner(ctx, id, userID)
+	case gqlmodel.OwnedResourceGroup:
+		err = r.EventService.CheckGroupOwner(ctx, id, userID)
+	default:
+		err = fmt.Errorf("@owner: unsupported resource %s", resource)
+	}
+	if errors.Is(err, event.ErrNotEventOwner) || errors.Is(err, event.ErrNotGroupOwner) {
+		return nil, fmt.Errorf("%w: %w", errForbidden, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+// fieldArg 读取当前字段的字符串参数
+func fieldArg(ctx context.Context, name *string) (string, bool) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || name == nil {
+		return "", false
+	}
+	v, ok := fc.Args[*name].(string)
+	return strings.TrimSpace(v), ok
+}
+
+// jwtUserID 当前通过 JWT 登录的用户；API key 和会话只能由用户本人登录后管理，不能用 API key 操作
+func jwtUserID(ctx context.Context) (string, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unauthorized: %w", err)
+	}
+	if _, ok := middleware.GetAPIKeyFromContext(ctx); ok {
+		return "", fmt.Errorf("forbidden: %w", middleware.ErrAPIKeyNotAllowed)
+	}
+	return userID, nil
+}
@@ -0,0 +1,202 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pgx "github.com/jackc/pgx/v5"
+	gqlgenerated "github.com/shiqi/datai/backend/gql/generated"
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// CreateEvent is the resolver for the createEvent field.
+func (r *mutationResolver) CreateEvent(ctx context.Context, input gqlmodel.CreateEventInput) (*gqlmodel.Event, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	startTime, err := parseTime(input.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := parseTime(input.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	requireApproval := false
+	if input.RequireApproval != nil {
+		requireApproval = *input.RequireApproval
+	}
+	// 选中了租户时创建的是该租户的活动，否则是公开活动
+	tenantID, _ := middleware.GetActiveTenantFromContext(ctx)
+
+	result, err := r.EventService.CreateEvent(ctx, event.CreateEventInput{
+		OwnerUID:         userID,
+		Title:            input.Title,
+		Description:      stringValue(input.Description),
+		PrimaryTag:       stringValue(input.PrimaryTag),
+		SecondaryTags:    input.SecondaryTags,
+		StartTime:        startTime,
+		EndTime:          endTime,
+		LocationType:     toLocationType(input.LocationType),
+		LocationDetail:   stringValue(input.LocationDetail),
+		CoverImage:       stringValue(input.CoverImage),
+		RequireApproval:  requireApproval,
+		ParticipantLimit: input.ParticipantLimit,
+		TenantID:         tenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEvent(result), nil
+}
+
+// UpdateEvent is the resolver for the updateEvent field.
+func (r *mutationResolver) UpdateEvent(ctx context.Context, id string, input gqlmodel.UpdateEventInput) (*gqlmodel.Event, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eventID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := parseOptionalTime(input.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := parseOptionalTime(input.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	var locationType *string
+	if input.LocationType != nil {
+		lt := toLocationType(*input.LocationType)
+		locationType = &lt
+	}
+
+	result, err := r.EventService.UpdateEvent(ctx, event.UpdateEventInput{
+		EventID:               eventID,
+		OwnerUID:              userID,
+		Title:                 input.Title,
+		Description:           input.Description,
+		PrimaryTag:            input.PrimaryTag,
+		SecondaryTags:         input.SecondaryTags,
+		StartTime:             startTime,
+		EndTime:               endTime,
+		LocationType:          locationType,
+		LocationDetail:        input.LocationDetail,
+		CoverImage:            input.CoverImage,
+		RequireApproval:       input.RequireApproval,
+		ParticipantLimit:      input.ParticipantLimit,
+		ClearParticipantLimit: input.ClearParticipantLimit != nil && *input.ClearParticipantLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEvent(result), nil
+}
+
+// CancelEvent is the resolver for the cancelEvent field.
+func (r *mutationResolver) CancelEvent(ctx context.Context, id string, reason *string) (*gqlmodel.Event, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eventID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.CancelEvent(ctx, eventID, userID, stringValue(reason))
+	if err != nil {
+		return nil, err
+	}
+	return toEvent(result), nil
+}
+
+// Event is the resolver for the event field.
+func (r *queryResolver) Event(ctx context.Context, id string) (*gqlmodel.Event, error) {
+	eventID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.GetEventByID(ctx, eventID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toEvent(result), nil
+}
+
+// Events is the resolver for the events field.
+func (r *queryResolver) Events(ctx context.Context, filter *gqlmodel.EventFilter) ([]*gqlmodel.Event, error) {
+	f := event.ListEventsFilter{}
+	if filter != nil {
+		var err error
+		if f.OwnerID, err = parseOptionalID(filter.OwnerID); err != nil {
+			return nil, err
+		}
+		if f.GroupID, err = parseOptionalID(filter.GroupID); err != nil {
+			return nil, err
+		}
+		if f.StartsAfter, err = parseOptionalTime(filter.StartsAfter); err != nil {
+			return nil, err
+		}
+		if f.StartsBefore, err = parseOptionalTime(filter.StartsBefore); err != nil {
+			return nil, err
+		}
+		if filter.LocationType != nil {
+			lt := toLocationType(*filter.LocationType)
+			f.LocationType = &lt
+		}
+		f.PrimaryTag = filter.PrimaryTag
+		if filter.IncludeCancelled != nil {
+			f.IncludeCancelled = *filter.IncludeCancelled
+		}
+		if filter.Limit != nil {
+			f.Limit = *filter.Limit
+		}
+		if filter.Offset != nil {
+			f.Offset = *filter.Offset
+		}
+	}
+
+	results, err := r.EventService.ListEvents(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	return toEvents(results), nil
+}
+
+// EventUpdated is the resolver for the eventUpdated field.
+func (r *subscriptionResolver) EventUpdated(ctx context.Context, id string) (<-chan *gqlmodel.Event, error) {
+	eventID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.EventService.SubscribeEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return convertChan(ctx, events, toEvent), nil
+}
+
+// Event returns gqlgenerated.EventResolver implementation.
+func (r *Resolver) Event() gqlgenerated.EventResolver { return &eventResolver{r} }
+
+type eventResolver struct{ *Resolver }
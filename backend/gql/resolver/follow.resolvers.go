@@ -0,0 +1,139 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// Follow is the resolver for the follow field.
+func (r *mutationResolver) Follow(ctx context.Context, userID string) (*gqlmodel.User, error) {
+	uid, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	followeeID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.Follow(ctx, uid, followeeID)
+	if err != nil {
+		return nil, err
+	}
+	return toUser(result), nil
+}
+
+// Unfollow is the resolver for the unfollow field.
+func (r *mutationResolver) Unfollow(ctx context.Context, userID string) (*gqlmodel.User, error) {
+	uid, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	followeeID, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.Unfollow(ctx, uid, followeeID)
+	if err != nil {
+		return nil, err
+	}
+	return toUser(result), nil
+}
+
+// FollowerCount is the resolver for the followerCount field.
+func (r *userResolver) FollowerCount(ctx context.Context, obj *gqlmodel.User) (int32, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return 0, err
+	}
+	return r.loadersFor(ctx).FollowerCount.Load(ctx, id)
+}
+
+// FollowingCount is the resolver for the followingCount field.
+func (r *userResolver) FollowingCount(ctx context.Context, obj *gqlmodel.User) (int32, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return 0, err
+	}
+	return r.loadersFor(ctx).FollowingCount.Load(ctx, id)
+}
+
+// Followers is the resolver for the followers field.
+func (r *userResolver) Followers(ctx context.Context, obj *gqlmodel.User, first *int32, after *string) (*gqlmodel.UserConnection, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := user.NewFollowPageKey(id, int32Value(first), stringValue(after))
+	if err != nil {
+		return nil, err
+	}
+	loaders := r.loadersFor(ctx)
+	page, err := loaders.Followers.Load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	total, err := loaders.FollowerCount.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toUserConnection(page, total), nil
+}
+
+// Following is the resolver for the following field.
+func (r *userResolver) Following(ctx context.Context, obj *gqlmodel.User, first *int32, after *string) (*gqlmodel.UserConnection, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := user.NewFollowPageKey(id, int32Value(first), stringValue(after))
+	if err != nil {
+		return nil, err
+	}
+	loaders := r.loadersFor(ctx)
+	page, err := loaders.Following.Load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	total, err := loaders.FollowingCount.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toUserConnection(page, total), nil
+}
+
+// IsFollowedByMe is the resolver for the isFollowedByMe field.
+func (r *userResolver) IsFollowedByMe(ctx context.Context, obj *gqlmodel.User) (bool, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return false, err
+	}
+	return r.loadersFor(ctx).FollowedByMe.Load(ctx, id)
+}
+
+// IsMutualFollow is the resolver for the isMutualFollow field.
+func (r *userResolver) IsMutualFollow(ctx context.Context, obj *gqlmodel.User) (bool, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return false, err
+	}
+	loaders := r.loadersFor(ctx)
+	followed, err := loaders.FollowedByMe.Load(ctx, id)
+	if err != nil || !followed {
+		return false, err
+	}
+	return loaders.FollowsMe.Load(ctx, id)
+}
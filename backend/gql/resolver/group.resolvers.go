@@ -0,0 +1,174 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// CreateGroup is the resolver for the createGroup field.
+func (r *mutationResolver) CreateGroup(ctx context.Context, input gqlmodel.CreateGroupInput) (*gqlmodel.EventGroup, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	isPublic := true
+	if input.IsPublic != nil {
+		isPublic = *input.IsPublic
+	}
+
+	result, err := r.EventService.CreateGroup(ctx, event.CreateGroupInput{
+		OwnerUID:    userID,
+		Name:        input.Name,
+		Description: stringValue(input.Description),
+		Tags:        input.Tags,
+		Pictures:    input.Pictures,
+		IsPublic:    isPublic,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEventGroup(result), nil
+}
+
+// UpdateGroup is the resolver for the updateGroup field.
+func (r *mutationResolver) UpdateGroup(ctx context.Context, id string, input gqlmodel.UpdateGroupInput) (*gqlmodel.EventGroup, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	groupID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.UpdateGroup(ctx, event.UpdateGroupInput{
+		GroupID:     groupID,
+		OwnerUID:    userID,
+		Name:        input.Name,
+		Description: input.Description,
+		Tags:        input.Tags,
+		Pictures:    input.Pictures,
+		IsPublic:    input.IsPublic,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEventGroup(result), nil
+}
+
+// SetEventGroup is the resolver for the setEventGroup field.
+func (r *mutationResolver) SetEventGroup(ctx context.Context, eventID string, groupID *string) (*gqlmodel.Event, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eid, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	gid, err := parseOptionalID(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.SetEventGroup(ctx, eid, gid, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toEvent(result), nil
+}
+
+// SubscribeGroup is the resolver for the subscribeGroup field.
+func (r *mutationResolver) SubscribeGroup(ctx context.Context, id string) (*gqlmodel.EventGroup, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	groupID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.SubscribeGroup(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toEventGroup(result), nil
+}
+
+// UnsubscribeGroup is the resolver for the unsubscribeGroup field.
+func (r *mutationResolver) UnsubscribeGroup(ctx context.Context, id string) (bool, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	groupID, err := parseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.EventService.UnsubscribeGroup(ctx, groupID, userID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Group is the resolver for the group field.
+func (r *queryResolver) Group(ctx context.Context, id string) (*gqlmodel.EventGroup, error) {
+	groupID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未登录时只能看到公开系列
+	userID, _ := middleware.GetUserIDFromContext(ctx)
+	result, err := r.EventService.GetGroup(ctx, groupID, userID)
+	if errors.Is(err, event.ErrGroupNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toEventGroup(result), nil
+}
+
+// Groups is the resolver for the groups field.
+func (r *queryResolver) Groups(ctx context.Context, limit *int32, offset *int32) ([]*gqlmodel.EventGroup, error) {
+	results, err := r.EventService.ListPublicGroups(ctx, int32Value(limit), int32Value(offset))
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*gqlmodel.EventGroup, 0, len(results))
+	for i := range results {
+		groups = append(groups, toEventGroup(&results[i]))
+	}
+	return groups, nil
+}
+
+// MySubscribedGroupEvents is the resolver for the mySubscribedGroupEvents field.
+func (r *queryResolver) MySubscribedGroupEvents(ctx context.Context, limit *int32, offset *int32) ([]*gqlmodel.Event, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	results, err := r.EventService.ListSubscribedGroupEvents(ctx, userID, int32Value(limit), int32Value(offset))
+	if err != nil {
+		return nil, err
+	}
+	return toEvents(results), nil
+}
@@ -0,0 +1,115 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	userdb "github.com/shiqi/datai/backend/db/user"
+	"github.com/shiqi/datai/backend/internal/dataloader"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// 每个请求一组 DataLoader，列表中每个 User 的关注计数、关注/粉丝列表、关注关系、履历合并成批量查询
+
+type loadersKey struct{}
+
+type Loaders struct {
+	FollowerCount  *dataloader.Loader[int64, int32]
+	FollowingCount *dataloader.Loader[int64, int32]
+	Followers      *dataloader.Loader[user.FollowPageKey, *user.FollowPage]
+	Following      *dataloader.Loader[user.FollowPageKey, *user.FollowPage]
+	FollowedByMe   *dataloader.Loader[int64, bool] // 当前用户是否关注了 key
+	FollowsMe      *dataloader.Loader[int64, bool] // key 是否关注了当前用户
+	Educations     *dataloader.Loader[int64, []userdb.UserEducation]
+	Employments    *dataloader.Loader[int64, []userdb.UserEmployment]
+
+	viewerOnce sync.Once
+	viewerID   int64
+	viewerErr  error
+}
+
+func (r *Resolver) newLoaders() *Loaders {
+	l := &Loaders{}
+	l.FollowerCount = dataloader.New(r.UserService.CountFollowers, dataloader.Options{})
+	l.FollowingCount = dataloader.New(r.UserService.CountFollowing, dataloader.Options{})
+	l.Followers = dataloader.New(r.UserService.ListFollowersByKeys, dataloader.Options{})
+	l.Following = dataloader.New(r.UserService.ListFollowingByKeys, dataloader.Options{})
+	l.Educations = dataloader.New(r.UserService.ListEducations, dataloader.Options{})
+	l.Employments = dataloader.New(r.UserService.ListEmployments, dataloader.Options{})
+	l.FollowedByMe = dataloader.New(func(ctx context.Context, ids []int64) (map[int64]bool, error) {
+		viewerID, err := l.viewer(ctx, r)
+		if err != nil || viewerID == 0 {
+			return nil, err
+		}
+		return r.UserService.FollowedBy(ctx, viewerID, ids)
+	}, dataloader.Options{})
+	l.FollowsMe = dataloader.New(func(ctx context.Context, ids []int64) (map[int64]bool, error) {
+		viewerID, err := l.viewer(ctx, r)
+		if err != nil || viewerID == 0 {
+			return nil, err
+		}
+		return r.UserService.Follows(ctx, ids, viewerID)
+	}, dataloader.Options{})
+	return l
+}
+
+// viewer 当前登录用户在 user_db 中的 ID，匿名或尚未建档时为 0
+func (l *Loaders) viewer(ctx context.Context, r *Resolver) (int64, error) {
+	l.viewerOnce.Do(func() {
+		uid, err := middleware.GetUserIDFromContext(ctx)
+		if err != nil {
+			return
+		}
+		u, err := r.UserService.GetUserByUID(ctx, uid)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return
+		}
+		if err != nil {
+			l.viewerErr = err
+			return
+		}
+		l.viewerID = u.ID
+	})
+	return l.viewerID, l.viewerErr
+}
+
+// LoaderMiddleware 为每个请求注入一组新的 DataLoader，需要放在认证中间件之后
+// WebSocket 连接存活时间长、认证发生在握手之后，不注入，由 loadersFor 每次临时创建
+func (r *Resolver) LoaderMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if middleware.IsWebSocketUpgrade(req) {
+			next.ServeHTTP(w, req)
+			return
+		}
+		ctx := context.WithValue(req.Context(), loadersKey{}, r.newLoaders())
+		next.ServeHTTP(w, req.WithContext(ctx))
+	})
+}
+
+// loadersFor 取当前请求的 DataLoader；没有经过 LoaderMiddleware 时临时创建一组
+func (r *Resolver) loadersFor(ctx context.Context) *Loaders {
+	if l, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return l
+	}
+	return r.newLoaders()
+}
+
+// currentUserID 当前登录用户在 user_db 中的 ID，匿名或尚未建档时返回 unauthorized
+func (r *Resolver) currentUserID(ctx context.Context) (int64, error) {
+	if _, err := middleware.GetUserIDFromContext(ctx); err != nil {
+		return 0, fmt.Errorf("unauthorized: %w", err)
+	}
+	viewerID, err := r.loadersFor(ctx).viewer(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+	if viewerID == 0 {
+		return 0, fmt.Errorf("unauthorized: %w", pgx.ErrNoRows)
+	}
+	return viewerID, nil
+}
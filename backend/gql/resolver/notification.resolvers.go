@@ -0,0 +1,86 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+)
+
+// MarkRead is the resolver for the markRead field.
+func (r *mutationResolver) MarkRead(ctx context.Context, id string) (*gqlmodel.Notification, error) {
+	userID, err := r.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notificationID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.NotificationService.MarkRead(ctx, userID, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	return toNotification(result), nil
+}
+
+// MarkAllRead is the resolver for the markAllRead field.
+func (r *mutationResolver) MarkAllRead(ctx context.Context) (int32, error) {
+	userID, err := r.currentUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.NotificationService.MarkAllRead(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return int32(count), nil
+}
+
+// Notifications is the resolver for the notifications field.
+func (r *queryResolver) Notifications(ctx context.Context, first *int32, after *string, unreadOnly *bool) (*gqlmodel.NotificationConnection, error) {
+	userID, err := r.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := r.NotificationService.List(ctx, userID, int32Value(first), stringValue(after), unreadOnly != nil && *unreadOnly)
+	if err != nil {
+		return nil, err
+	}
+	return toNotificationConnection(page), nil
+}
+
+// UnreadNotificationCount is the resolver for the unreadNotificationCount field.
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int32, error) {
+	userID, err := r.currentUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.NotificationService.UnreadCount(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return int32(count), nil
+}
+
+// NotificationAdded is the resolver for the notificationAdded field.
+func (r *subscriptionResolver) NotificationAdded(ctx context.Context) (<-chan *gqlmodel.Notification, error) {
+	userID, err := r.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := r.NotificationService.Subscribe(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return convertChan(ctx, notifications, toNotification), nil
+}
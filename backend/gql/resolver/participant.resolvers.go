@@ -0,0 +1,146 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// Participants is the resolver for the participants field.
+func (r *eventResolver) Participants(ctx context.Context, obj *gqlmodel.Event, status *gqlmodel.ParticipantStatus) ([]*gqlmodel.EventParticipant, error) {
+	eventID, err := parseID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var statusFilter *string
+	if status != nil {
+		s := toParticipantStatusValue(*status)
+		statusFilter = &s
+	}
+
+	// 匿名访问时 viewerUID 为空，服务层只返回 approved 的报名者
+	viewerUID, _ := middleware.GetUserIDFromContext(ctx)
+	results, err := r.EventService.ListParticipants(ctx, eventID, statusFilter, viewerUID)
+	if err != nil {
+		return nil, err
+	}
+	participants := make([]*gqlmodel.EventParticipant, 0, len(results))
+	for i := range results {
+		participants = append(participants, toEventParticipant(&results[i]))
+	}
+	return participants, nil
+}
+
+// JoinEvent is the resolver for the joinEvent field.
+func (r *mutationResolver) JoinEvent(ctx context.Context, eventID string) (*gqlmodel.EventParticipant, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	id, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.JoinEvent(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toEventParticipant(result), nil
+}
+
+// LeaveEvent is the resolver for the leaveEvent field.
+func (r *mutationResolver) LeaveEvent(ctx context.Context, eventID string) (*gqlmodel.EventParticipant, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	id, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.LeaveEvent(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toEventParticipant(result), nil
+}
+
+// ApproveParticipant is the resolver for the approveParticipant field.
+func (r *mutationResolver) ApproveParticipant(ctx context.Context, eventID string, userID string) (*gqlmodel.EventParticipant, error) {
+	ownerUID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eid, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.ApproveParticipant(ctx, eid, uid, ownerUID)
+	if err != nil {
+		return nil, err
+	}
+	return toEventParticipant(result), nil
+}
+
+// RejectParticipant is the resolver for the rejectParticipant field.
+func (r *mutationResolver) RejectParticipant(ctx context.Context, eventID string, userID string) (*gqlmodel.EventParticipant, error) {
+	ownerUID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eid, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.RejectParticipant(ctx, eid, uid, ownerUID)
+	if err != nil {
+		return nil, err
+	}
+	return toEventParticipant(result), nil
+}
+
+// MarkAttendance is the resolver for the markAttendance field.
+func (r *mutationResolver) MarkAttendance(ctx context.Context, eventID string, userID string, attended bool) (*gqlmodel.EventParticipant, error) {
+	ownerUID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eid, err := parseID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.MarkAttendance(ctx, eid, uid, ownerUID, attended)
+	if err != nil {
+		return nil, err
+	}
+	return toEventParticipant(result), nil
+}
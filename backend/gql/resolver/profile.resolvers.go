@@ -0,0 +1,194 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// AddEducation is the resolver for the addEducation field.
+func (r *mutationResolver) AddEducation(ctx context.Context, input gqlmodel.EducationInput) (*gqlmodel.Education, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	result, err := r.UserService.AddEducation(ctx, userID, user.EducationInput{
+		SchoolName: input.SchoolName,
+		Degree:     stringValue(input.Degree),
+		Major:      stringValue(input.Major),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEducation(result), nil
+}
+
+// UpdateEducation is the resolver for the updateEducation field.
+func (r *mutationResolver) UpdateEducation(ctx context.Context, id string, input gqlmodel.UpdateEducationInput) (*gqlmodel.Education, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	educationID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.UpdateEducation(ctx, userID, educationID, user.UpdateEducationInput{
+		SchoolName: input.SchoolName,
+		Degree:     input.Degree,
+		Major:      input.Major,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEducation(result), nil
+}
+
+// DeleteEducation is the resolver for the deleteEducation field.
+func (r *mutationResolver) DeleteEducation(ctx context.Context, id string) (bool, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	educationID, err := parseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.UserService.DeleteEducation(ctx, userID, educationID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReorderEducations is the resolver for the reorderEducations field.
+func (r *mutationResolver) ReorderEducations(ctx context.Context, ids []string) ([]*gqlmodel.Education, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	educationIDs, err := parseIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.UserService.ReorderEducations(ctx, userID, educationIDs)
+	if err != nil {
+		return nil, err
+	}
+	return toEducations(results), nil
+}
+
+// AddEmployment is the resolver for the addEmployment field.
+func (r *mutationResolver) AddEmployment(ctx context.Context, input gqlmodel.EmploymentInput) (*gqlmodel.Employment, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	result, err := r.UserService.AddEmployment(ctx, userID, user.EmploymentInput{
+		CompanyName: input.CompanyName,
+		JobTitle:    stringValue(input.JobTitle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEmployment(result), nil
+}
+
+// UpdateEmployment is the resolver for the updateEmployment field.
+func (r *mutationResolver) UpdateEmployment(ctx context.Context, id string, input gqlmodel.UpdateEmploymentInput) (*gqlmodel.Employment, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	employmentID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.UpdateEmployment(ctx, userID, employmentID, user.UpdateEmploymentInput{
+		CompanyName: input.CompanyName,
+		JobTitle:    input.JobTitle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEmployment(result), nil
+}
+
+// DeleteEmployment is the resolver for the deleteEmployment field.
+func (r *mutationResolver) DeleteEmployment(ctx context.Context, id string) (bool, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	employmentID, err := parseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.UserService.DeleteEmployment(ctx, userID, employmentID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReorderEmployments is the resolver for the reorderEmployments field.
+func (r *mutationResolver) ReorderEmployments(ctx context.Context, ids []string) ([]*gqlmodel.Employment, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	employmentIDs, err := parseIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.UserService.ReorderEmployments(ctx, userID, employmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	return toEmployments(results), nil
+}
+
+// Educations is the resolver for the educations field.
+func (r *userResolver) Educations(ctx context.Context, obj *gqlmodel.User) ([]*gqlmodel.Education, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	results, err := r.loadersFor(ctx).Educations.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toEducations(results), nil
+}
+
+// Employments is the resolver for the employments field.
+func (r *userResolver) Employments(ctx context.Context, obj *gqlmodel.User) ([]*gqlmodel.Employment, error) {
+	id, err := parseID(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	results, err := r.loadersFor(ctx).Employments.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toEmployments(results), nil
+}
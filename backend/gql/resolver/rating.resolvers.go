@@ -0,0 +1,82 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// RateEvent is the resolver for the rateEvent field.
+func (r *mutationResolver) RateEvent(ctx context.Context, input gqlmodel.RateEventInput) (*gqlmodel.EventRating, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eventID, err := parseID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.RateEvent(ctx, event.RateEventInput{
+		EventID: eventID,
+		UID:     userID,
+		Score:   input.Score,
+		Comment: stringValue(input.Comment),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.EventRating{
+		ID:        formatID(result.ID),
+		EventID:   formatID(result.EventID),
+		RaterID:   formatID(result.RaterID),
+		Score:     int32(result.Score),
+		Comment:   optionalText(result.Comment),
+		CreatedAt: optionalTime(result.CreatedAt),
+	}, nil
+}
+
+// RateParticipant is the resolver for the rateParticipant field.
+func (r *mutationResolver) RateParticipant(ctx context.Context, input gqlmodel.RateParticipantInput) (*gqlmodel.UserRating, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	eventID, err := parseID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	targetUserID, err := parseID(input.TargetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.EventService.RateParticipant(ctx, event.RateParticipantInput{
+		EventID:      eventID,
+		UID:          userID,
+		TargetUserID: targetUserID,
+		Score:        input.Score,
+		Comment:      stringValue(input.Comment),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.UserRating{
+		ID:           formatID(result.ID),
+		EventID:      formatID(result.EventID),
+		RaterID:      formatID(result.RaterID),
+		TargetUserID: formatID(result.TargetUserID),
+		Score:        int32(result.Score),
+		Comment:      optionalText(result.Comment),
+		CreatedAt:    optionalTime(result.CreatedAt),
+	}, nil
+}
@@ -1,12 +1,20 @@
 package resolver
 
-import "github.com/shiqi/datai/backend/internal/user"
+import (
+	"github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/notification"
+	"github.com/shiqi/datai/backend/internal/tenant"
+	"github.com/shiqi/datai/backend/internal/user"
+)
 
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	UserRepo    *user.Repository
-	UserService *user.Service
+	UserRepo            *user.Repository
+	UserService         *user.Service
+	EventService        *event.Service
+	TenantService       *tenant.Service
+	NotificationService *notification.Service
 }
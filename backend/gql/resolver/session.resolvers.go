@@ -0,0 +1,61 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (*gqlmodel.Session, error) {
+	userID, err := jwtUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionID, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.UserService.RevokeSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	current, _ := middleware.GetSessionFromContext(ctx)
+	return toSession(result, current), nil
+}
+
+// RevokeAllSessions is the resolver for the revokeAllSessions field.
+func (r *mutationResolver) RevokeAllSessions(ctx context.Context) (int32, error) {
+	userID, err := jwtUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := r.UserService.RevokeAllSessions(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return int32(n), nil
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*gqlmodel.Session, error) {
+	userID, err := jwtUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := r.UserService.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	current, _ := middleware.GetSessionFromContext(ctx)
+	return toSessions(sessions, current), nil
+}
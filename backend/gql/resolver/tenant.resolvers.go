@@ -0,0 +1,134 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"fmt"
+
+	gqlmodel "github.com/shiqi/datai/backend/gql/model"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/tenant"
+)
+
+// CreateTenant is the resolver for the createTenant field.
+func (r *mutationResolver) CreateTenant(ctx context.Context, input gqlmodel.CreateTenantInput) (*gqlmodel.Tenant, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	result, err := r.TenantService.CreateTenant(ctx, tenant.CreateTenantInput{
+		ID:       input.ID,
+		Name:     input.Name,
+		Region:   input.Region,
+		OwnerUID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTenant(result), nil
+}
+
+// AddTenantMember is the resolver for the addTenantMember field.
+func (r *mutationResolver) AddTenantMember(ctx context.Context, tenantID string, userID string, role gqlmodel.TenantRole) (*gqlmodel.TenantMember, error) {
+	actorUID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	uid, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.TenantService.AddMember(ctx, tenantID, uid, toTenantRoleValue(role), actorUID)
+	if err != nil {
+		return nil, err
+	}
+	return toTenantMember(result), nil
+}
+
+// ChangeTenantMemberRole is the resolver for the changeTenantMemberRole field.
+func (r *mutationResolver) ChangeTenantMemberRole(ctx context.Context, tenantID string, userID string, role gqlmodel.TenantRole) (*gqlmodel.TenantMember, error) {
+	actorUID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	uid, err := parseID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.TenantService.ChangeMemberRole(ctx, tenantID, uid, toTenantRoleValue(role), actorUID)
+	if err != nil {
+		return nil, err
+	}
+	return toTenantMember(result), nil
+}
+
+// RemoveTenantMember is the resolver for the removeTenantMember field.
+func (r *mutationResolver) RemoveTenantMember(ctx context.Context, tenantID string, userID string) (bool, error) {
+	actorUID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	uid, err := parseID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.TenantService.RemoveMember(ctx, tenantID, uid, actorUID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MyTenants is the resolver for the myTenants field.
+func (r *queryResolver) MyTenants(ctx context.Context) ([]*gqlmodel.TenantMembership, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	rows, err := r.TenantService.MyTenants(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*gqlmodel.TenantMembership, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &gqlmodel.TenantMembership{
+			Tenant: &gqlmodel.Tenant{
+				ID:        row.ID,
+				Name:      row.Name,
+				Region:    row.Region,
+				CreatedAt: optionalTime(row.CreatedAt),
+			},
+			Role:     toTenantRole(row.Role),
+			JoinedAt: optionalTime(row.JoinedAt),
+		})
+	}
+	return result, nil
+}
+
+// TenantMembers is the resolver for the tenantMembers field.
+func (r *queryResolver) TenantMembers(ctx context.Context, tenantID string) ([]*gqlmodel.TenantMember, error) {
+	userID, err := middleware.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	members, err := r.TenantService.ListMembers(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*gqlmodel.TenantMember, 0, len(members))
+	for i := range members {
+		result = append(result, toTenantMember(&members[i]))
+	}
+	return result, nil
+}
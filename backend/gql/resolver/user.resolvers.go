@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	gqlgenerated "github.com/shiqi/datai/backend/gql/generated"
 	gqlmodel "github.com/shiqi/datai/backend/gql/model"
 	"github.com/shiqi/datai/backend/internal/middleware"
 	"github.com/shiqi/datai/backend/internal/user"
@@ -40,26 +41,7 @@ func (r *mutationResolver) UpsertUser(ctx context.Context, input gqlmodel.Upsert
 	}
 
 	// 转换Service输出到GraphQL模型
-	nicknameResult := ""
-	if result.Nickname.Valid {
-		nicknameResult = result.Nickname.String
-	}
-	avatarResult := ""
-	if result.Avatar.Valid {
-		avatarResult = result.Avatar.String
-	}
-	createdAt := ""
-	if result.CreatedAt.Valid {
-		createdAt = result.CreatedAt.Time.Format("2006-01-02T15:04:05Z")
-	}
-
-	return &gqlmodel.User{
-		ID:        fmt.Sprintf("%d", result.ID),
-		UID:       result.Uid,
-		Nickname:  &nicknameResult,
-		Avatar:    &avatarResult,
-		CreatedAt: &createdAt,
-	}, nil
+	return toUser(result), nil
 }
 
 // Me is the resolver for the me field.
@@ -79,24 +61,10 @@ func (r *queryResolver) Me(ctx context.Context) (*gqlmodel.User, error) {
 		return nil, fmt.Errorf("user not found")
 	}
 
-	nickname := ""
-	if user.Nickname.Valid {
-		nickname = user.Nickname.String
-	}
-	avatar := ""
-	if user.Avatar.Valid {
-		avatar = user.Avatar.String
-	}
-	createdAt := ""
-	if user.CreatedAt.Valid {
-		createdAt = user.CreatedAt.Time.Format("2006-01-02T15:04:05Z")
-	}
-
-	return &gqlmodel.User{
-		ID:        fmt.Sprintf("%d", user.ID),
-		UID:       user.Uid,
-		Nickname:  &nickname,
-		Avatar:    &avatar,
-		CreatedAt: &createdAt,
-	}, nil
+	return toUser(user), nil
 }
+
+// User returns gqlgenerated.UserResolver implementation.
+func (r *Resolver) User() gqlgenerated.UserResolver { return &userResolver{r} }
+
+type userResolver struct{ *Resolver }
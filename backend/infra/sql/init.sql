@@ -1,3 +1,4 @@
 CREATE DATABASE user_db;
 CREATE DATABASE events_db;
-CREATE DATABASE tenant_db;
\ No newline at end of file
+CREATE DATABASE tenant_db;
+CREATE DATABASE log_db;
\ No newline at end of file
@@ -0,0 +1,155 @@
+package bus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+)
+
+// 领域事件总线，用于 GraphQL subscription 等推送
+// Memory 只在本进程内分发；Postgres 通过 LISTEN/NOTIFY 分发到连接同一数据库的所有实例
+// 投递是尽力而为的：订阅者处理不过来或连接中断期间的消息会丢失，需要可靠投递的副作用走 outbox
+
+const defaultBufferSize = 16
+
+// MaxPayloadSize 单条消息 payload 的上限，Postgres NOTIFY 的 payload 必须小于 8000 字节，还要留出 topic 的位置
+const MaxPayloadSize = 7000
+
+var (
+	ErrPayloadTooLarge = errors.New("bus: payload too large")
+	ErrInvalidPayload  = errors.New("bus: payload must be valid JSON")
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+}
+
+type Subscriber interface {
+	// Subscribe 订阅 topic，ctx 结束时取消订阅并关闭返回的 channel
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+}
+
+type Bus interface {
+	Publisher
+	Subscriber
+}
+
+// Memory 进程内实现，订阅者处理不过来时丢弃消息，不阻塞发布方；用于单实例部署和测试
+type Memory struct {
+	mu     sync.RWMutex
+	topics map[string]map[chan []byte]struct{}
+}
+
+var _ Bus = (*Memory)(nil)
+
+func NewMemory() *Memory {
+	return &Memory{topics: make(map[string]map[chan []byte]struct{})}
+}
+
+// Publish 与 Postgres 实现一样校验 payload，避免只在多实例部署时才暴露问题
+func (b *Memory) Publish(ctx context.Context, topic string, payload []byte) error {
+	if err := checkPayload(payload); err != nil {
+		return err
+	}
+	b.deliver(topic, payload)
+	return nil
+}
+
+// deliver 把消息分发给本进程内的订阅者
+func (b *Memory) deliver(topic string, payload []byte) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.topics[topic] {
+		select {
+		case ch <- payload:
+		default:
+			slog.Warn("bus subscriber is full, message dropped", "topic", topic)
+		}
+	}
+}
+
+func (b *Memory) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	ch := make(chan []byte, defaultBufferSize)
+
+	b.mu.Lock()
+	subs, ok := b.topics[topic]
+	if !ok {
+		subs = make(map[chan []byte]struct{})
+		b.topics[topic] = subs
+	}
+	subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(subs, ch)
+		if len(subs) == 0 {
+			delete(b.topics, topic)
+		}
+		b.mu.Unlock()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+func checkPayload(payload []byte) error {
+	if len(payload) > MaxPayloadSize {
+		return ErrPayloadTooLarge
+	}
+	if !json.Valid(payload) {
+		return ErrInvalidPayload
+	}
+	return nil
+}
+
+// IDMessage 只携带实体 ID 的消息，订阅方收到后重新查询，保证权限校验和数据最新
+type IDMessage struct {
+	ID int64 `json:"id"`
+}
+
+func PublishID(ctx context.Context, p Publisher, topic string, id int64) error {
+	payload, err := json.Marshal(IDMessage{ID: id})
+	if err != nil {
+		return err
+	}
+	return p.Publish(ctx, topic, payload)
+}
+
+// Relay 订阅 topic，把每条 IDMessage 经 fetch 查询后写入返回的 channel
+// fetch 返回 nil 时跳过该消息（例如已无权查看），ctx 结束时关闭 channel
+func Relay[T any](ctx context.Context, s Subscriber, topic string, fetch func(ctx context.Context, id int64) (*T, error)) (<-chan *T, error) {
+	msgs, err := s.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *T, 1)
+	go func() {
+		defer close(out)
+		for payload := range msgs {
+			var msg IDMessage
+			if err := json.Unmarshal(payload, &msg); err != nil {
+				slog.Warn("bus received invalid message", "topic", topic, "error", err)
+				continue
+			}
+			item, err := fetch(ctx, msg.ID)
+			if err != nil {
+				slog.Error("bus failed to load message", "topic", topic, "id", msg.ID, "error", err)
+				continue
+			}
+			if item == nil {
+				continue
+			}
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
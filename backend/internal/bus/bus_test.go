@@ -0,0 +1,216 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func receive(t *testing.T, ch <-chan []byte) []byte {
+	t.Helper()
+	select {
+	case msg, ok := <-ch:
+		if !ok {
+			t.Fatal("channel closed")
+		}
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+		return nil
+	}
+}
+
+func TestMemoryFanOut(t *testing.T) {
+	b := NewMemory()
+	ctx := context.Background()
+
+	first, err := b.Subscribe(ctx, "event:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := b.Subscribe(ctx, "event:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := b.Subscribe(ctx, "event:2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Publish(ctx, "event:1", []byte(`{"id":1}`)); err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range []<-chan []byte{first, second} {
+		if got := receive(t, ch); string(got) != `{"id":1}` {
+			t.Errorf("received %s, want {\"id\":1}", got)
+		}
+	}
+	select {
+	case msg := <-other:
+		t.Fatalf("subscriber of another topic received %s", msg)
+	default:
+	}
+}
+
+func TestMemoryUnsubscribeOnCancel(t *testing.T) {
+	b := NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := b.Subscribe(ctx, "event:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatal("received a message after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel not closed after ctx was cancelled")
+	}
+
+	b.mu.RLock()
+	_, ok := b.topics["event:1"]
+	b.mu.RUnlock()
+	if ok {
+		t.Fatal("topic without subscribers not removed")
+	}
+	// 取消订阅后发布不会写入已关闭的 channel
+	if err := b.Publish(context.Background(), "event:1", []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryDropsForFullSubscriber(t *testing.T) {
+	b := NewMemory()
+	ctx := context.Background()
+	slow, err := b.Subscribe(ctx, "event:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fast, err := b.Subscribe(ctx, "event:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const published = defaultBufferSize + 5
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range published {
+			if err := PublishID(ctx, b, "event:1", int64(i)); err != nil {
+				t.Error(err)
+			}
+			<-fast // deliver 是同步的，消息已在 channel 中
+		}
+	}()
+	// 订阅者处理不过来时不阻塞发布方
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Publish blocked on a full subscriber")
+	}
+
+	if got := len(slow); got != defaultBufferSize {
+		t.Fatalf("slow subscriber buffered %d messages, want %d", got, defaultBufferSize)
+	}
+	// 保留最早的消息，之后的被丢弃
+	if got := receive(t, slow); string(got) != `{"id":0}` {
+		t.Fatalf("first buffered message = %s, want {\"id\":0}", got)
+	}
+}
+
+func TestMemoryPublishChecksPayload(t *testing.T) {
+	b := NewMemory()
+	tests := []struct {
+		name    string
+		payload []byte
+		wantErr error
+	}{
+		{name: "valid", payload: []byte(`{"id":1}`)},
+		{name: "at limit", payload: jsonString(MaxPayloadSize)},
+		{name: "too large", payload: jsonString(MaxPayloadSize + 1), wantErr: ErrPayloadTooLarge},
+		{name: "not json", payload: []byte("id=1"), wantErr: ErrInvalidPayload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := b.Publish(context.Background(), "event:1", tt.payload); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Publish() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPostgresPublishChecksNotifyLimit(t *testing.T) {
+	// 超限的消息在发送 NOTIFY 之前就被拒绝，不需要数据库连接
+	p := NewPostgres(nil, "")
+	tests := []struct {
+		name    string
+		topic   string
+		payload []byte
+	}{
+		{name: "payload over MaxPayloadSize", topic: "event:1", payload: jsonString(MaxPayloadSize + 1)},
+		{name: "envelope reaches notifyLimit", topic: strings.Repeat("t", notifyLimit-MaxPayloadSize), payload: jsonString(MaxPayloadSize)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := p.Publish(context.Background(), tt.topic, tt.payload); !errors.Is(err, ErrPayloadTooLarge) {
+				t.Fatalf("Publish() error = %v, want %v", err, ErrPayloadTooLarge)
+			}
+		})
+	}
+}
+
+func TestRelay(t *testing.T) {
+	b := NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type item struct{ id int64 }
+	out, err := Relay(ctx, b, "event:1", func(_ context.Context, id int64) (*item, error) {
+		switch id {
+		case 2:
+			return nil, nil // 无权查看
+		case 3:
+			return nil, errors.New("not found")
+		}
+		return &item{id: id}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []int64{1, 2, 3, 4} {
+		if err := PublishID(ctx, b, "event:1", id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, want := range []int64{1, 4} {
+		select {
+		case got := <-out:
+			if got.id != want {
+				t.Fatalf("relayed %d, want %d", got.id, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("item %d not relayed", want)
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatal("unexpected item after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("relay channel not closed after ctx was cancelled")
+	}
+}
+
+// jsonString 长度为 n 字节的 JSON 字符串
+func jsonString(n int) []byte {
+	return []byte(`"` + strings.Repeat("a", n-2) + `"`)
+}
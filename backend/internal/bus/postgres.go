@@ -0,0 +1,118 @@
+package bus
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Postgres 通过 LISTEN/NOTIFY 在多个实例之间分发消息
+// 所有 topic 共用一个通知频道，每个实例只保持一条 LISTEN 连接，收到后按 topic 分发给本进程内的订阅者
+
+const (
+	// DefaultChannel 默认的通知频道
+	DefaultChannel = "datai_bus"
+
+	// notifyLimit Postgres NOTIFY payload 的上限（不含）
+	notifyLimit = 8000
+
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// envelope 写入 NOTIFY payload 的消息格式
+type envelope struct {
+	Topic   string          `json:"t"`
+	Payload json.RawMessage `json:"p"`
+}
+
+type Postgres struct {
+	pool    *pgxpool.Pool
+	channel string
+	local   *Memory
+}
+
+var _ Bus = (*Postgres)(nil)
+
+// NewPostgres 创建基于 pool 所连数据库的总线，需要调用 Run 开始接收其他实例的消息
+func NewPostgres(pool *pgxpool.Pool, channel string) *Postgres {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	return &Postgres{pool: pool, channel: channel, local: NewMemory()}
+}
+
+// Publish 发送 NOTIFY；本实例的订阅者同样经由 LISTEN 连接收到消息，与其他实例的顺序一致
+func (p *Postgres) Publish(ctx context.Context, topic string, payload []byte) error {
+	if err := checkPayload(payload); err != nil {
+		return err
+	}
+	data, err := json.Marshal(envelope{Topic: topic, Payload: payload})
+	if err != nil {
+		return err
+	}
+	if len(data) >= notifyLimit {
+		return ErrPayloadTooLarge
+	}
+	_, err = p.pool.Exec(ctx, "SELECT pg_notify($1, $2)", p.channel, string(data))
+	return err
+}
+
+func (p *Postgres) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	return p.local.Subscribe(ctx, topic)
+}
+
+// Run 保持 LISTEN 连接并分发收到的消息，连接断开后按指数退避重连，直到 ctx 结束
+// 断线期间其他实例发布的消息会丢失
+func (p *Postgres) Run(ctx context.Context) {
+	delay := minReconnectDelay
+	for {
+		listening, err := p.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if listening {
+			delay = minReconnectDelay
+		}
+		slog.Warn("bus listen interrupted, reconnecting", "channel", p.channel, "retry_in", delay, "error", err)
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// listen 建立一次 LISTEN 连接并阻塞接收通知；listening 表示是否成功进入监听状态
+func (p *Postgres) listen(ctx context.Context) (listening bool, err error) {
+	pooled, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	// 监听连接长期占用且断开后不能复用，从连接池中摘出来单独管理
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{p.channel}.Sanitize()); err != nil {
+		return false, err
+	}
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+		var env envelope
+		if err := json.Unmarshal([]byte(n.Payload), &env); err != nil || env.Topic == "" {
+			slog.Warn("bus received invalid notification", "channel", p.channel, "payload", n.Payload)
+			continue
+		}
+		p.local.deliver(env.Topic, env.Payload)
+	}
+}
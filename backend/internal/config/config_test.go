@@ -0,0 +1,101 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactDSN(t *testing.T) {
+	tests := []struct {
+		name string
+		dsn  string
+		want string
+	}{
+		{
+			name: "url userinfo",
+			dsn:  "postgres://datai:s3cret@db:5432/user_db?sslmode=disable",
+			want: "postgres://datai:REDACTED@db:5432/user_db?sslmode=disable",
+		},
+		{
+			name: "url query password",
+			dsn:  "postgres://db:5432/user_db?password=s3cret&user=datai",
+			want: "postgres://db:5432/user_db?password=REDACTED&user=datai",
+		},
+		{
+			name: "url without password",
+			dsn:  "postgres://datai@db:5432/user_db",
+			want: "postgres://datai@db:5432/user_db",
+		},
+		{
+			name: "key value",
+			dsn:  "host=db user=datai password=s3cret dbname=user_db",
+			want: "host=db user=datai password=REDACTED dbname=user_db",
+		},
+		{
+			name: "key value quoted with spaces",
+			dsn:  `host=db password = 'it\'s secret' dbname=user_db`,
+			want: "host=db password=REDACTED dbname=user_db",
+		},
+		{
+			name: "key value without password",
+			dsn:  "host=db user=datai dbname=user_db",
+			want: "host=db user=datai dbname=user_db",
+		},
+		{
+			name: "unparsable url",
+			dsn:  "postgres://datai:s3cret@db:bad-port/user_db",
+			want: redacted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RedactDSN(tt.dsn)
+			if got != tt.want {
+				t.Errorf("RedactDSN(%q) = %q, want %q", tt.dsn, got, tt.want)
+			}
+			if strings.Contains(got, "s3cret") || strings.Contains(got, "secret'") {
+				t.Errorf("RedactDSN(%q) leaked the password: %q", tt.dsn, got)
+			}
+		})
+	}
+}
+
+func TestRedactedHidesSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.Database.Password = "db-s3cret"
+	cfg.Auth.ClientSecret = "hs-s3cret"
+	cfg.Auth.Issuers = []IssuerConfig{{Issuer: "sso", ClientSecret: "sso-s3cret"}}
+	cfg.Database.DSNs = map[string]string{"events": "postgres://db/events_db?password=dsn-s3cret"}
+
+	out := cfg.Redacted()
+	if strings.Contains(out, "s3cret") {
+		t.Fatalf("Redacted() leaked a secret:\n%s", out)
+	}
+	if cfg.Auth.Issuers[0].ClientSecret != "sso-s3cret" {
+		t.Fatal("Redacted() modified the original config")
+	}
+}
+
+func TestValidateDevJWKSURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "default", url: ""},
+		{name: "container host", url: "http://datai:8080/.well-known/jwks.json"},
+		{name: "relative path", url: "/.well-known/jwks.json", wantErr: true},
+		{name: "missing scheme", url: "datai:8080/.well-known/jwks.json", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Auth.Dev.Enabled = true
+			cfg.Auth.Dev.JWKSURL = tt.url
+			err := cfg.Validate()
+			if gotErr := err != nil && strings.Contains(err.Error(), "auth.dev: jwks_url"); gotErr != tt.wantErr {
+				t.Fatalf("Validate() error = %v, want jwks_url error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Package dataloader 把同一请求内对同类数据的零散查询合并成批量查询，避免列表渲染时的 N+1。
+//
+// Loader 只应在单个请求内使用：结果会缓存到请求结束，不感知数据变化。
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultWait     = 2 * time.Millisecond
+	defaultMaxBatch = 100
+)
+
+// BatchFunc 按 keys 批量取数据，返回的 map 中缺少的 key 得到零值
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+type Options struct {
+	Wait     time.Duration // 收到第一个 key 后等待更多 key 的时间
+	MaxBatch int           // 单批最多 key 数，达到后立即发起查询
+}
+
+type Loader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	cache map[K]*result[V]
+	batch *batch[K, V]
+}
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	results []*result[V]
+	closed  bool
+}
+
+func New[K comparable, V any](fetch BatchFunc[K, V], opts Options) *Loader[K, V] {
+	if opts.Wait <= 0 {
+		opts.Wait = defaultWait
+	}
+	if opts.MaxBatch <= 0 {
+		opts.MaxBatch = defaultMaxBatch
+	}
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     opts.Wait,
+		maxBatch: opts.MaxBatch,
+		cache:    make(map[K]*result[V]),
+	}
+}
+
+// Load 取单个 key，与同一时间窗口内的其他 Load 合并成一次 BatchFunc 调用
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	if r, ok := l.cache[key]; ok {
+		l.mu.Unlock()
+		return r.wait(ctx)
+	}
+
+	r := &result[V]{done: make(chan struct{})}
+	l.cache[key] = r
+	if l.batch == nil {
+		l.batch = &batch[K, V]{}
+		go l.dispatchAfter(ctx, l.batch)
+	}
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, r)
+	if len(b.keys) >= l.maxBatch {
+		l.batch = nil
+		b.closed = true
+		go l.run(ctx, b)
+	}
+	l.mu.Unlock()
+
+	return r.wait(ctx)
+}
+
+func (l *Loader[K, V]) dispatchAfter(ctx context.Context, b *batch[K, V]) {
+	time.Sleep(l.wait)
+
+	l.mu.Lock()
+	if b.closed {
+		l.mu.Unlock()
+		return
+	}
+	b.closed = true
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+
+	l.run(ctx, b)
+}
+
+func (l *Loader[K, V]) run(ctx context.Context, b *batch[K, V]) {
+	values, err := l.fetch(context.WithoutCancel(ctx), b.keys)
+	for i, key := range b.keys {
+		r := b.results[i]
+		if err != nil {
+			r.err = err
+		} else {
+			r.value = values[key]
+		}
+		close(r.done)
+	}
+
+	// 失败的 key 不缓存，后续 Load 可以重试
+	if err != nil {
+		l.mu.Lock()
+		for i, key := range b.keys {
+			if l.cache[key] == b.results[i] {
+				delete(l.cache, key)
+			}
+		}
+		l.mu.Unlock()
+	}
+}
+
+func (r *result[V]) wait(ctx context.Context) (V, error) {
+	select {
+	case <-r.done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
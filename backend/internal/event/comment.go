@@ -0,0 +1,240 @@
+package event
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+)
+
+const (
+	defaultCommentMaxDepth = 3
+	defaultCommentPageSize = 20
+	maxCommentPageSize     = 50
+	maxCommentLength       = 2000
+)
+
+var (
+	ErrCommentEmpty     = errors.New("comment content is required")
+	ErrCommentTooLong   = fmt.Errorf("comment content must be at most %d characters", maxCommentLength)
+	ErrParentNotInEvent = errors.New("parent comment does not belong to this event")
+	ErrNotCommentAuthor = errors.New("only the comment author or event owner can delete this comment")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrCommentNotFound  = errors.New("comment not found")
+)
+
+// CommentNode 一条评论及其回复树
+type CommentNode struct {
+	Comment eventdb.EventComment
+	Depth   int32
+	Replies []*CommentNode
+}
+
+// CommentPage 一页顶层评论，按创建时间倒序
+type CommentPage struct {
+	Nodes       []*CommentNode
+	TotalCount  int64
+	HasNextPage bool
+	EndCursor   string
+}
+
+type PostCommentInput struct {
+	EventID  int64
+	UID      string
+	Content  string
+	ParentID *int64
+}
+
+// PostComment 发表评论或回复，昵称在写入时从用户服务快照
+func (s *Service) PostComment(ctx context.Context, input PostCommentInput) (*eventdb.EventComment, error) {
+	content := strings.TrimSpace(input.Content)
+	if content == "" {
+		return nil, ErrCommentEmpty
+	}
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return nil, ErrCommentTooLong
+	}
+
+	author, err := s.userService.GetUserByUID(ctx, input.UID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.GetEventByID(ctx, input.EventID); err != nil {
+		return nil, err
+	}
+
+	arg := eventdb.CreateCommentParams{
+		EventID:      input.EventID,
+		UserID:       author.ID,
+		UserNickname: author.Nickname,
+		Content:      content,
+	}
+	if input.ParentID != nil {
+		parent, err := s.eventRepo.GetCommentByID(ctx, *input.ParentID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommentNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		if parent.EventID != input.EventID {
+			return nil, ErrParentNotInEvent
+		}
+		arg.ParentID = pgtype.Int8{Int64: parent.ID, Valid: true}
+	}
+
+	result, err := s.eventRepo.CreateComment(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	s.publishCommentAdded(ctx, result)
+	return result, nil
+}
+
+// DeleteComment 删除评论，回复会随 parent_id 外键级联删除
+func (s *Service) DeleteComment(ctx context.Context, commentID int64, uid string) (*eventdb.EventComment, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.eventRepo.GetCommentByID(ctx, commentID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCommentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != u.ID {
+		event, err := s.eventRepo.GetEventByID(ctx, comment.EventID)
+		if err != nil {
+			return nil, err
+		}
+		if event.OwnerID != u.ID {
+			return nil, ErrNotCommentAuthor
+		}
+	}
+
+	if err := s.eventRepo.DeleteComment(ctx, comment.ID); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// ListComments 分页返回顶层评论，并一次性加载最多 CommentMaxDepth 层回复
+func (s *Service) ListComments(ctx context.Context, eventID int64, first int32, after string) (*CommentPage, error) {
+	if first <= 0 {
+		first = defaultCommentPageSize
+	}
+	if first > maxCommentPageSize {
+		first = maxCommentPageSize
+	}
+	if _, err := s.GetEventByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	arg := eventdb.ListTopLevelCommentsParams{
+		EventID: eventID,
+		Limit:   first + 1, // 多取一条判断是否还有下一页
+	}
+	if after != "" {
+		createdAt, id, err := decodeCommentCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		arg.AfterCreatedAt = pgtype.Timestamptz{Time: createdAt, Valid: true}
+		arg.AfterID = pgtype.Int8{Int64: id, Valid: true}
+	}
+
+	comments, err := s.eventRepo.ListTopLevelComments(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.eventRepo.CountTopLevelComments(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &CommentPage{TotalCount: total}
+	if int32(len(comments)) > first {
+		page.HasNextPage = true
+		comments = comments[:first]
+	}
+	if len(comments) == 0 {
+		page.Nodes = []*CommentNode{}
+		return page, nil
+	}
+
+	nodes := make(map[int64]*CommentNode, len(comments))
+	rootIDs := make([]int64, 0, len(comments))
+	for _, c := range comments {
+		node := &CommentNode{Comment: c, Replies: []*CommentNode{}}
+		nodes[c.ID] = node
+		page.Nodes = append(page.Nodes, node)
+		rootIDs = append(rootIDs, c.ID)
+	}
+	page.EndCursor = CommentCursor(comments[len(comments)-1])
+
+	replies, err := s.eventRepo.ListCommentReplies(ctx, rootIDs, int32(s.opts.CommentMaxDepth))
+	if err != nil {
+		return nil, err
+	}
+	// 回复按创建时间正序返回，父节点一定先于子节点出现
+	for _, r := range replies {
+		parent, ok := nodes[r.ParentID.Int64]
+		if !ok {
+			continue
+		}
+		node := &CommentNode{
+			Comment: eventdb.EventComment{
+				ID:           r.ID,
+				EventID:      r.EventID,
+				UserID:       r.UserID,
+				UserNickname: r.UserNickname,
+				Content:      r.Content,
+				ParentID:     r.ParentID,
+				CreatedAt:    r.CreatedAt,
+			},
+			Depth:   r.Depth,
+			Replies: []*CommentNode{},
+		}
+		nodes[r.ID] = node
+		parent.Replies = append(parent.Replies, node)
+	}
+
+	return page, nil
+}
+
+// CommentCursor 返回评论的不透明分页游标
+func CommentCursor(c eventdb.EventComment) string {
+	raw := c.CreatedAt.Time.UTC().Format(time.RFC3339Nano) + "|" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCommentCursor(cursor string) (time.Time, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	createdAtStr, idStr, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	return createdAt, id, nil
+}
@@ -0,0 +1,287 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+)
+
+var (
+	ErrGroupNameRequired = errors.New("group name is required")
+	ErrNotGroupOwner     = errors.New("only the group owner can modify this group")
+	ErrGroupNotFound     = errors.New("group not found")
+)
+
+type CreateGroupInput struct {
+	OwnerUID    string
+	Name        string
+	Description string
+	Tags        []string
+	Pictures    []string
+	IsPublic    bool
+}
+
+// UpdateGroupInput 中为 nil 的字段保持原值不变
+type UpdateGroupInput struct {
+	GroupID     int64
+	OwnerUID    string
+	Name        *string
+	Description *string
+	Tags        []string
+	Pictures    []string
+	IsPublic    *bool
+}
+
+func (s *Service) CreateGroup(ctx context.Context, input CreateGroupInput) (*eventdb.EventGroup, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, ErrGroupNameRequired
+	}
+	owner, err := s.userService.GetUserByUID(ctx, input.OwnerUID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := encodeTags(input.Tags)
+	if err != nil {
+		return nil, err
+	}
+	pictures, err := encodeTags(input.Pictures)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.eventRepo.CreateGroup(ctx, eventdb.CreateGroupParams{
+		Name:        name,
+		OwnerID:     owner.ID,
+		Description: textOrNull(input.Description),
+		Tags:        tags,
+		Pictures:    pictures,
+		IsPublic:    pgtype.Bool{Bool: input.IsPublic, Valid: true},
+	})
+}
+
+func (s *Service) UpdateGroup(ctx context.Context, input UpdateGroupInput) (*eventdb.EventGroup, error) {
+	existing, err := s.getOwnedGroup(ctx, input.GroupID, input.OwnerUID)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := eventdb.UpdateGroupParams{
+		ID:          existing.ID,
+		Name:        existing.Name,
+		Description: existing.Description,
+		Tags:        existing.Tags,
+		Pictures:    existing.Pictures,
+		IsPublic:    existing.IsPublic,
+	}
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return nil, ErrGroupNameRequired
+		}
+		arg.Name = name
+	}
+	if input.Description != nil {
+		arg.Description = textOrNull(*input.Description)
+	}
+	if input.Tags != nil {
+		if arg.Tags, err = encodeTags(input.Tags); err != nil {
+			return nil, err
+		}
+	}
+	if input.Pictures != nil {
+		if arg.Pictures, err = encodeTags(input.Pictures); err != nil {
+			return nil, err
+		}
+	}
+	if input.IsPublic != nil {
+		arg.IsPublic = pgtype.Bool{Bool: *input.IsPublic, Valid: true}
+	}
+
+	return s.eventRepo.UpdateGroup(ctx, arg)
+}
+
+// GetGroup 读取系列；非公开系列只对发起人可见
+func (s *Service) GetGroup(ctx context.Context, groupID int64, viewerUID string) (*eventdb.EventGroup, error) {
+	group, err := s.eventRepo.GetGroupByID(ctx, groupID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrGroupNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if group.IsPublic.Bool {
+		return group, nil
+	}
+	if viewerUID != "" {
+		viewer, err := s.userService.GetUserByUID(ctx, viewerUID)
+		if err == nil && viewer.ID == group.OwnerID {
+			return group, nil
+		}
+	}
+	return nil, ErrGroupNotFound
+}
+
+func (s *Service) ListPublicGroups(ctx context.Context, limit, offset int32) ([]eventdb.EventGroup, error) {
+	limit, offset = normalizePage(limit, offset)
+	return s.eventRepo.ListPublicGroups(ctx, limit, offset)
+}
+
+// SetEventGroup 把活动挂到系列下（groupID 为 nil 时移出系列），需要同时是活动和系列的发起人。
+// 新旧两个系列的计数和评分都会重新计算。
+func (s *Service) SetEventGroup(ctx context.Context, eventID int64, groupID *int64, ownerUID string) (*eventdb.Event, error) {
+	owner, err := s.userService.GetUserByUID(ctx, ownerUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *eventdb.Event
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		existing, err := txRepo.lockOwnedEvent(ctx, eventID, owner.ID)
+		if err != nil {
+			return err
+		}
+
+		var groupIDs []int64
+		if existing.GroupID.Valid {
+			groupIDs = append(groupIDs, existing.GroupID.Int64)
+		}
+		newGroup := pgtype.Int8{}
+		if groupID != nil {
+			groupIDs = append(groupIDs, *groupID)
+			newGroup = pgtype.Int8{Int64: *groupID, Valid: true}
+		}
+		if err := txRepo.lockGroups(ctx, groupIDs...); err != nil {
+			return err
+		}
+		if groupID != nil {
+			if _, err := txRepo.ownedGroup(ctx, *groupID, owner.ID); err != nil {
+				return err
+			}
+		}
+
+		if result, err = txRepo.SetEventGroup(ctx, existing.ID, newGroup); err != nil {
+			return err
+		}
+		for _, id := range groupIDs {
+			if err := txRepo.RefreshGroupCounters(ctx, id); err != nil {
+				return err
+			}
+			if err := txRepo.RecomputeGroupScore(ctx, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, result.ID)
+	return result, nil
+}
+
+func (s *Service) SubscribeGroup(ctx context.Context, groupID int64, uid string) (*eventdb.EventGroup, error) {
+	group, err := s.GetGroup(ctx, groupID, uid)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.eventRepo.SubscribeGroup(ctx, u.ID, group.ID); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (s *Service) UnsubscribeGroup(ctx context.Context, groupID int64, uid string) error {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return err
+	}
+	return s.eventRepo.UnsubscribeGroup(ctx, u.ID, groupID)
+}
+
+func (s *Service) IsSubscribedToGroup(ctx context.Context, groupID int64, uid string) (bool, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return false, err
+	}
+	return s.eventRepo.IsSubscribedToGroup(ctx, u.ID, groupID)
+}
+
+// ListSubscribedGroupEvents 当前用户订阅的系列中尚未开始的活动，按开始时间排序
+func (s *Service) ListSubscribedGroupEvents(ctx context.Context, uid string, limit, offset int32) ([]eventdb.Event, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset = normalizePage(limit, offset)
+	return s.eventRepo.ListSubscribedGroupEvents(ctx, eventdb.ListSubscribedGroupEventsParams{
+		UserID:    u.ID,
+		TenantIds: visibleTenantIDs(ctx),
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+// CheckGroupOwner 确认 uid 是系列发起人，供 @owner 指令使用
+func (s *Service) CheckGroupOwner(ctx context.Context, groupID int64, uid string) error {
+	_, err := s.getOwnedGroup(ctx, groupID, uid)
+	return err
+}
+
+func (s *Service) getOwnedGroup(ctx context.Context, groupID int64, ownerUID string) (*eventdb.EventGroup, error) {
+	owner, err := s.userService.GetUserByUID(ctx, ownerUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.eventRepo.ownedGroup(ctx, groupID, owner.ID)
+}
+
+// ownedGroup 读取系列并确认 ownerID 是发起人
+func (r *Repository) ownedGroup(ctx context.Context, groupID, ownerID int64) (*eventdb.EventGroup, error) {
+	group, err := r.GetGroupByID(ctx, groupID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrGroupNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if group.OwnerID != ownerID {
+		return nil, ErrNotGroupOwner
+	}
+	return group, nil
+}
+
+// lockGroups 按 id 升序锁定系列行。同时涉及多个系列的事务都按这个顺序加锁，
+// 例如两个活动在同一对系列之间反向移动时，不会各自持有一把锁等待对方而死锁
+func (r *Repository) lockGroups(ctx context.Context, groupIDs ...int64) error {
+	ids := slices.Clone(groupIDs)
+	slices.Sort(ids)
+	for _, id := range slices.Compact(ids) {
+		err := r.LockGroup(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrGroupNotFound
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshEventGroup 活动或报名变化后同步所属系列的计数
+func (r *Repository) refreshEventGroup(ctx context.Context, event *eventdb.Event) error {
+	if !event.GroupID.Valid {
+		return nil
+	}
+	return r.RefreshGroupCounters(ctx, event.GroupID.Int64)
+}
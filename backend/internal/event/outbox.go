@@ -0,0 +1,37 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/shiqi/datai/backend/internal/outbox"
+)
+
+// events_db 中的 outbox 消息类型
+const (
+	// OutboxUserRatingChanged 用户收到新评分，需要重新计算 user_db 中的评分汇总
+	OutboxUserRatingChanged = "user_rating_changed"
+)
+
+type userRatingChanged struct {
+	UserID int64 `json:"userId"`
+}
+
+// RegisterOutboxHandlers 注册 events_db outbox 的处理函数
+func (s *Service) RegisterOutboxHandlers(relay *outbox.Relay) {
+	relay.Handle(OutboxUserRatingChanged, s.handleUserRatingChanged)
+}
+
+// handleUserRatingChanged 以 events_db 中的 user_ratings 为准重新计算汇总，写回 user_db 的 users 表
+// 重新计算天然幂等，重复投递没有副作用
+func (s *Service) handleUserRatingChanged(ctx context.Context, msg outbox.Message) error {
+	var payload userRatingChanged
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return err
+	}
+	stats, err := s.eventRepo.GetUserRatingStats(ctx, payload.UserID)
+	if err != nil {
+		return err
+	}
+	return s.userService.UpdateRatingStats(ctx, payload.UserID, stats.RatingAvg, stats.RatingCount)
+}
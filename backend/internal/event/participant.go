@@ -0,0 +1,336 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+)
+
+// 报名状态，对应 event_participants.status 的 CHECK 约束
+const (
+	ParticipantPending    = "pending"
+	ParticipantApproved   = "approved"
+	ParticipantRejected   = "rejected"
+	ParticipantWaitlisted = "waitlisted"
+	ParticipantAttended   = "attended"
+	ParticipantNoShow     = "no_show"
+)
+
+var (
+	ErrEventEnded          = errors.New("event has already ended")
+	ErrAlreadyJoined       = errors.New("already joined this event")
+	ErrParticipantRejected = errors.New("your request to join this event was rejected")
+	ErrNotParticipant      = errors.New("user is not a participant of this event")
+	ErrInvalidTransition   = errors.New("participant status cannot be changed from its current state")
+	ErrEventNotStarted     = errors.New("event has not started yet")
+)
+
+// JoinEvent 报名活动。
+// 需要审核的活动进入 pending 队列；不需要审核时名额未满直接 approved，满员则进入 waitlisted。
+// 事务内对 events 行加锁，保证并发报名时不会超出 participant_limit。
+func (s *Service) JoinEvent(ctx context.Context, eventID int64, uid string) (*eventdb.EventParticipant, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *eventdb.EventParticipant
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		event, err := txRepo.GetEventForUpdate(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if !canSeeEvent(ctx, event) {
+			return pgx.ErrNoRows
+		}
+		if event.CancelledAt.Valid {
+			return ErrEventCancelled
+		}
+		if !event.EndTime.Time.After(time.Now()) {
+			return ErrEventEnded
+		}
+
+		existing, err := txRepo.GetParticipant(ctx, eventID, u.ID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		// 被拒绝的记录即使带有 left_at 也不能重新报名，否则会绕过发起人的拒绝
+		if existing != nil && existing.Status.String == ParticipantRejected {
+			return ErrParticipantRejected
+		}
+		if existing != nil && !existing.LeftAt.Valid {
+			return ErrAlreadyJoined
+		}
+
+		status := ParticipantPending
+		if !event.RequireApproval.Bool {
+			if status, err = txRepo.admissionStatus(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		if result, err = txRepo.UpsertParticipant(ctx, eventID, u.ID, status); err != nil {
+			return err
+		}
+		return txRepo.refreshEventGroup(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, eventID)
+	return result, nil
+}
+
+// LeaveEvent 退出活动；已通过的报名者退出后自动递补候补队列。被拒绝的报名不能退出，以免借此重新报名
+func (s *Service) LeaveEvent(ctx context.Context, eventID int64, uid string) (*eventdb.EventParticipant, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *eventdb.EventParticipant
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		event, err := txRepo.GetEventForUpdate(ctx, eventID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := txRepo.GetParticipant(ctx, eventID, u.ID)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && existing.LeftAt.Valid) {
+			return ErrNotParticipant
+		}
+		if err != nil {
+			return err
+		}
+		if existing.Status.String == ParticipantRejected {
+			return ErrParticipantRejected
+		}
+
+		if result, err = txRepo.MarkParticipantLeft(ctx, eventID, u.ID); err != nil {
+			return err
+		}
+		if existing.Status.String == ParticipantApproved {
+			if err := txRepo.promoteWaitlisted(ctx, event); err != nil {
+				return err
+			}
+		}
+		return txRepo.refreshEventGroup(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, eventID)
+	return result, nil
+}
+
+// ApproveParticipant 发起人审核通过报名；名额已满时转入候补队列
+func (s *Service) ApproveParticipant(ctx context.Context, eventID, userID int64, ownerUID string) (*eventdb.EventParticipant, error) {
+	if _, err := s.getOwnedEvent(ctx, eventID, ownerUID); err != nil {
+		return nil, err
+	}
+
+	var result *eventdb.EventParticipant
+	err := s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		event, err := txRepo.GetEventForUpdate(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if event.CancelledAt.Valid {
+			return ErrEventCancelled
+		}
+
+		existing, err := txRepo.getActiveParticipant(ctx, eventID, userID)
+		if err != nil {
+			return err
+		}
+		if existing.Status.String != ParticipantPending && existing.Status.String != ParticipantWaitlisted {
+			return ErrInvalidTransition
+		}
+
+		status, err := txRepo.admissionStatus(ctx, event)
+		if err != nil {
+			return err
+		}
+		if result, err = txRepo.UpdateParticipantStatus(ctx, eventID, userID, status); err != nil {
+			return err
+		}
+		return txRepo.refreshEventGroup(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, eventID)
+	return result, nil
+}
+
+// RejectParticipant 发起人拒绝报名；拒绝已通过的报名者会释放名额并递补候补
+func (s *Service) RejectParticipant(ctx context.Context, eventID, userID int64, ownerUID string) (*eventdb.EventParticipant, error) {
+	if _, err := s.getOwnedEvent(ctx, eventID, ownerUID); err != nil {
+		return nil, err
+	}
+
+	var result *eventdb.EventParticipant
+	err := s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		event, err := txRepo.GetEventForUpdate(ctx, eventID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := txRepo.getActiveParticipant(ctx, eventID, userID)
+		if err != nil {
+			return err
+		}
+		switch existing.Status.String {
+		case ParticipantPending, ParticipantWaitlisted, ParticipantApproved:
+		default:
+			return ErrInvalidTransition
+		}
+
+		if result, err = txRepo.UpdateParticipantStatus(ctx, eventID, userID, ParticipantRejected); err != nil {
+			return err
+		}
+		if existing.Status.String == ParticipantApproved {
+			if err := txRepo.promoteWaitlisted(ctx, event); err != nil {
+				return err
+			}
+		}
+		return txRepo.refreshEventGroup(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, eventID)
+	return result, nil
+}
+
+// MarkAttendance 活动开始后由发起人登记到场情况，只有 attended 的参与者可以评分
+func (s *Service) MarkAttendance(ctx context.Context, eventID, userID int64, ownerUID string, attended bool) (*eventdb.EventParticipant, error) {
+	event, err := s.getOwnedEvent(ctx, eventID, ownerUID)
+	if err != nil {
+		return nil, err
+	}
+	if event.StartTime.Time.After(time.Now()) {
+		return nil, ErrEventNotStarted
+	}
+
+	status := ParticipantNoShow
+	if attended {
+		status = ParticipantAttended
+	}
+
+	var result *eventdb.EventParticipant
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		existing, err := txRepo.getActiveParticipant(ctx, eventID, userID)
+		if err != nil {
+			return err
+		}
+		switch existing.Status.String {
+		case ParticipantApproved, ParticipantAttended, ParticipantNoShow:
+		default:
+			return ErrInvalidTransition
+		}
+
+		if result, err = txRepo.UpdateParticipantStatus(ctx, eventID, userID, status); err != nil {
+			return err
+		}
+		return txRepo.refreshEventGroup(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, eventID)
+	return result, nil
+}
+
+// ListParticipants 列出活动报名者。
+// 只有发起人可以查看 approved 以外的状态；匿名用户和其他用户（viewerUID 为空或非发起人）只能看到 approved 的报名者。
+func (s *Service) ListParticipants(ctx context.Context, eventID int64, status *string, viewerUID string) ([]eventdb.EventParticipant, error) {
+	if status == nil || *status != ParticipantApproved {
+		isOwner, err := s.isEventOwner(ctx, eventID, viewerUID)
+		if err != nil {
+			return nil, err
+		}
+		if !isOwner {
+			if status != nil {
+				return []eventdb.EventParticipant{}, nil
+			}
+			approved := ParticipantApproved
+			status = &approved
+		}
+	}
+
+	arg := eventdb.ListParticipantsParams{EventID: eventID}
+	if status != nil {
+		arg.Status = pgtype.Text{String: *status, Valid: true}
+	}
+	return s.eventRepo.ListParticipants(ctx, arg)
+}
+
+func (s *Service) isEventOwner(ctx context.Context, eventID int64, viewerUID string) (bool, error) {
+	if viewerUID == "" {
+		return false, nil
+	}
+	_, err := s.getOwnedEvent(ctx, eventID, viewerUID)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, ErrNotEventOwner), errors.Is(err, pgx.ErrNoRows):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// admissionStatus 根据剩余名额决定直接通过还是进入候补，调用方需已锁定 events 行
+func (r *Repository) admissionStatus(ctx context.Context, event *eventdb.Event) (string, error) {
+	if !event.ParticipantLimit.Valid {
+		return ParticipantApproved, nil
+	}
+	count, err := r.CountActiveParticipants(ctx, event.ID)
+	if err != nil {
+		return "", err
+	}
+	if count >= int64(event.ParticipantLimit.Int32) {
+		return ParticipantWaitlisted, nil
+	}
+	return ParticipantApproved, nil
+}
+
+// promoteWaitlisted 名额空出时按候补顺序把人转为 approved，直到名额用尽或候补队列为空
+func (r *Repository) promoteWaitlisted(ctx context.Context, event *eventdb.Event) error {
+	for {
+		status, err := r.admissionStatus(ctx, event)
+		if err != nil || status != ParticipantApproved {
+			return err
+		}
+
+		next, err := r.GetNextWaitlistedParticipant(ctx, event.ID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := r.UpdateParticipantStatus(ctx, event.ID, next.UserID, ParticipantApproved); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *Repository) getActiveParticipant(ctx context.Context, eventID, userID int64) (*eventdb.EventParticipant, error) {
+	participant, err := r.GetParticipant(ctx, eventID, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotParticipant
+	}
+	if err != nil {
+		return nil, err
+	}
+	if participant.LeftAt.Valid {
+		return nil, ErrNotParticipant
+	}
+	return participant, nil
+}
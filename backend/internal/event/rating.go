@@ -0,0 +1,143 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+)
+
+const (
+	minRatingScore = 1
+	maxRatingScore = 5
+)
+
+var (
+	ErrInvalidScore    = errors.New("score must be between 1 and 5")
+	ErrEventNotEnded   = errors.New("event can only be rated after it ends")
+	ErrNotAttended     = errors.New("only attended participants can rate")
+	ErrCannotRateSelf  = errors.New("cannot rate yourself")
+	ErrTargetNotAttend = errors.New("rated user did not attend this event")
+)
+
+type RateEventInput struct {
+	EventID int64
+	UID     string
+	Score   int32
+	Comment string
+}
+
+type RateParticipantInput struct {
+	EventID      int64
+	UID          string
+	TargetUserID int64
+	Score        int32
+	Comment      string
+}
+
+// RateEvent 为活动打分，重复打分会覆盖上一次的分数，随后重新计算所属系列的加权评分
+func (s *Service) RateEvent(ctx context.Context, input RateEventInput) (*eventdb.EventRating, error) {
+	if input.Score < minRatingScore || input.Score > maxRatingScore {
+		return nil, ErrInvalidScore
+	}
+	rater, event, err := s.checkRatingEligibility(ctx, input.EventID, input.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 评分和系列评分在同一事务中提交，重新计算失败时评分也不会保存
+	var rating *eventdb.EventRating
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		if rating, err = txRepo.UpsertEventRating(ctx, eventdb.UpsertEventRatingParams{
+			EventID: event.ID,
+			RaterID: rater,
+			Score:   int16(input.Score),
+			Comment: textOrNull(strings.TrimSpace(input.Comment)),
+		}); err != nil {
+			return err
+		}
+		if !event.GroupID.Valid {
+			return nil
+		}
+		// 先锁定系列行，避免并发评分用旧快照覆盖系列评分
+		if err := txRepo.LockGroup(ctx, event.GroupID.Int64); err != nil {
+			return err
+		}
+		return txRepo.RecomputeGroupScore(ctx, event.GroupID.Int64)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
+
+// RateParticipant 为同场活动的其他到场参与者打分，被评分用户的平均分随后由 outbox 重新计算
+func (s *Service) RateParticipant(ctx context.Context, input RateParticipantInput) (*eventdb.UserRating, error) {
+	if input.Score < minRatingScore || input.Score > maxRatingScore {
+		return nil, ErrInvalidScore
+	}
+	rater, event, err := s.checkRatingEligibility(ctx, input.EventID, input.UID)
+	if err != nil {
+		return nil, err
+	}
+	if rater == input.TargetUserID {
+		return nil, ErrCannotRateSelf
+	}
+
+	target, err := s.eventRepo.GetParticipant(ctx, event.ID, input.TargetUserID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && target.Status.String != ParticipantAttended) {
+		return nil, ErrTargetNotAttend
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// user_db 中的评分汇总由 outbox 异步重新计算，与评分写入同一事务提交
+	var rating *eventdb.UserRating
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		if rating, err = txRepo.UpsertUserRating(ctx, eventdb.UpsertUserRatingParams{
+			EventID:      event.ID,
+			RaterID:      rater,
+			TargetUserID: input.TargetUserID,
+			Score:        int16(input.Score),
+			Comment:      textOrNull(strings.TrimSpace(input.Comment)),
+		}); err != nil {
+			return err
+		}
+		return txRepo.EnqueueOutbox(ctx, OutboxUserRatingChanged, userRatingChanged{UserID: input.TargetUserID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
+
+// checkRatingEligibility 评分人必须已到场，且活动已经结束，返回评分人的用户 ID
+func (s *Service) checkRatingEligibility(ctx context.Context, eventID int64, uid string) (int64, *eventdb.Event, error) {
+	rater, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return 0, nil, err
+	}
+	event, err := s.eventRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if event.CancelledAt.Valid {
+		return 0, nil, ErrEventCancelled
+	}
+	if event.EndTime.Time.After(time.Now()) {
+		return 0, nil, ErrEventNotEnded
+	}
+
+	participant, err := s.eventRepo.GetParticipant(ctx, eventID, rater.ID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && participant.Status.String != ParticipantAttended) {
+		return 0, nil, ErrNotAttended
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	return rater.ID, event, nil
+}
@@ -0,0 +1,297 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	eventdb "github.com/shiqi/datai/backend/db/events" // sqlc 生成的包
+	"github.com/shiqi/datai/backend/internal/outbox"
+)
+
+type Repository struct {
+	q    *eventdb.Queries
+	pool *pgxpool.Pool
+}
+
+func NewRepository(q *eventdb.Queries, pool *pgxpool.Pool) *Repository {
+	return &Repository{q: q, pool: pool}
+}
+
+// WithTx 在同一个事务中执行 fn，fn 返回错误时回滚
+func (r *Repository) WithTx(ctx context.Context, fn func(txRepo *Repository) error) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return fn(&Repository{q: r.q.WithTx(tx), pool: r.pool})
+	})
+}
+
+func (r *Repository) GetEventByID(ctx context.Context, id int64) (*eventdb.Event, error) {
+	event, err := r.q.GetEventByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *Repository) CreateEvent(ctx context.Context, arg eventdb.CreateEventParams) (*eventdb.Event, error) {
+	event, err := r.q.CreateEvent(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *Repository) UpdateEvent(ctx context.Context, arg eventdb.UpdateEventParams) (*eventdb.Event, error) {
+	event, err := r.q.UpdateEvent(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *Repository) CancelEvent(ctx context.Context, arg eventdb.CancelEventParams) (*eventdb.Event, error) {
+	event, err := r.q.CancelEvent(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *Repository) ListEvents(ctx context.Context, arg eventdb.ListEventsParams) ([]eventdb.Event, error) {
+	return r.q.ListEvents(ctx, arg)
+}
+
+func (r *Repository) GetEventForUpdate(ctx context.Context, id int64) (*eventdb.Event, error) {
+	event, err := r.q.GetEventForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (r *Repository) GetParticipant(ctx context.Context, eventID, userID int64) (*eventdb.EventParticipant, error) {
+	participant, err := r.q.GetParticipant(ctx, eventdb.GetParticipantParams{EventID: eventID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+func (r *Repository) CountActiveParticipants(ctx context.Context, eventID int64) (int64, error) {
+	return r.q.CountActiveParticipants(ctx, eventID)
+}
+
+func (r *Repository) UpsertParticipant(ctx context.Context, eventID, userID int64, status string) (*eventdb.EventParticipant, error) {
+	participant, err := r.q.UpsertParticipant(ctx, eventdb.UpsertParticipantParams{
+		EventID: eventID,
+		UserID:  userID,
+		Status:  pgtype.Text{String: status, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+func (r *Repository) UpdateParticipantStatus(ctx context.Context, eventID, userID int64, status string) (*eventdb.EventParticipant, error) {
+	participant, err := r.q.UpdateParticipantStatus(ctx, eventdb.UpdateParticipantStatusParams{
+		EventID: eventID,
+		UserID:  userID,
+		Status:  pgtype.Text{String: status, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+func (r *Repository) MarkParticipantLeft(ctx context.Context, eventID, userID int64) (*eventdb.EventParticipant, error) {
+	participant, err := r.q.MarkParticipantLeft(ctx, eventdb.MarkParticipantLeftParams{EventID: eventID, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+func (r *Repository) GetNextWaitlistedParticipant(ctx context.Context, eventID int64) (*eventdb.EventParticipant, error) {
+	participant, err := r.q.GetNextWaitlistedParticipant(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return &participant, nil
+}
+
+func (r *Repository) ListParticipants(ctx context.Context, arg eventdb.ListParticipantsParams) ([]eventdb.EventParticipant, error) {
+	return r.q.ListParticipants(ctx, arg)
+}
+
+func (r *Repository) GetCommentByID(ctx context.Context, id int64) (*eventdb.EventComment, error) {
+	comment, err := r.q.GetCommentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *Repository) CreateComment(ctx context.Context, arg eventdb.CreateCommentParams) (*eventdb.EventComment, error) {
+	comment, err := r.q.CreateComment(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (r *Repository) DeleteComment(ctx context.Context, id int64) error {
+	return r.q.DeleteComment(ctx, id)
+}
+
+func (r *Repository) CountTopLevelComments(ctx context.Context, eventID int64) (int64, error) {
+	return r.q.CountTopLevelComments(ctx, eventID)
+}
+
+func (r *Repository) ListTopLevelComments(ctx context.Context, arg eventdb.ListTopLevelCommentsParams) ([]eventdb.EventComment, error) {
+	return r.q.ListTopLevelComments(ctx, arg)
+}
+
+func (r *Repository) ListCommentReplies(ctx context.Context, rootIDs []int64, maxDepth int32) ([]eventdb.ListCommentRepliesRow, error) {
+	return r.q.ListCommentReplies(ctx, eventdb.ListCommentRepliesParams{RootIds: rootIDs, MaxDepth: maxDepth})
+}
+
+func (r *Repository) ListAttendedParticipants(ctx context.Context, eventID int64) ([]eventdb.EventParticipant, error) {
+	return r.q.ListAttendedParticipants(ctx, eventID)
+}
+
+func (r *Repository) UpsertEventRating(ctx context.Context, arg eventdb.UpsertEventRatingParams) (*eventdb.EventRating, error) {
+	rating, err := r.q.UpsertEventRating(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &rating, nil
+}
+
+func (r *Repository) UpsertUserRating(ctx context.Context, arg eventdb.UpsertUserRatingParams) (*eventdb.UserRating, error) {
+	rating, err := r.q.UpsertUserRating(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &rating, nil
+}
+
+func (r *Repository) GetUserRatingStats(ctx context.Context, targetUserID int64) (*eventdb.GetUserRatingStatsRow, error) {
+	stats, err := r.q.GetUserRatingStats(ctx, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func (r *Repository) RecomputeGroupScore(ctx context.Context, groupID int64) error {
+	return r.q.RecomputeGroupScore(ctx, groupID)
+}
+
+func (r *Repository) GetGroupByID(ctx context.Context, id int64) (*eventdb.EventGroup, error) {
+	group, err := r.q.GetGroupByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *Repository) CreateGroup(ctx context.Context, arg eventdb.CreateGroupParams) (*eventdb.EventGroup, error) {
+	group, err := r.q.CreateGroup(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *Repository) UpdateGroup(ctx context.Context, arg eventdb.UpdateGroupParams) (*eventdb.EventGroup, error) {
+	group, err := r.q.UpdateGroup(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *Repository) ListPublicGroups(ctx context.Context, limit, offset int32) ([]eventdb.EventGroup, error) {
+	return r.q.ListPublicGroups(ctx, eventdb.ListPublicGroupsParams{Limit: limit, Offset: offset})
+}
+
+func (r *Repository) SetEventGroup(ctx context.Context, eventID int64, groupID pgtype.Int8) (*eventdb.Event, error) {
+	event, err := r.q.SetEventGroup(ctx, eventdb.SetEventGroupParams{ID: eventID, GroupID: groupID})
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// LockGroup 锁定系列行直到事务结束
+func (r *Repository) LockGroup(ctx context.Context, groupID int64) error {
+	_, err := r.q.LockGroup(ctx, groupID)
+	return err
+}
+
+// RefreshGroupCounters 先锁定系列行再重新统计，避免并发事务用旧快照覆盖计数
+func (r *Repository) RefreshGroupCounters(ctx context.Context, groupID int64) error {
+	if err := r.LockGroup(ctx, groupID); err != nil {
+		return err
+	}
+	return r.q.RefreshGroupCounters(ctx, groupID)
+}
+
+func (r *Repository) SubscribeGroup(ctx context.Context, userID, groupID int64) error {
+	return r.q.SubscribeGroup(ctx, eventdb.SubscribeGroupParams{UserID: userID, GroupID: groupID})
+}
+
+func (r *Repository) UnsubscribeGroup(ctx context.Context, userID, groupID int64) error {
+	return r.q.UnsubscribeGroup(ctx, eventdb.UnsubscribeGroupParams{UserID: userID, GroupID: groupID})
+}
+
+func (r *Repository) IsSubscribedToGroup(ctx context.Context, userID, groupID int64) (bool, error) {
+	return r.q.IsSubscribedToGroup(ctx, eventdb.IsSubscribedToGroupParams{UserID: userID, GroupID: groupID})
+}
+
+func (r *Repository) ListSubscribedGroupEvents(ctx context.Context, arg eventdb.ListSubscribedGroupEventsParams) ([]eventdb.Event, error) {
+	return r.q.ListSubscribedGroupEvents(ctx, arg)
+}
+
+// EnqueueOutbox 写入一条 outbox 消息，需要在业务写入的事务中调用（txRepo）
+func (r *Repository) EnqueueOutbox(ctx context.Context, kind string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return r.q.EnqueueOutbox(ctx, eventdb.EnqueueOutboxParams{Kind: kind, Payload: data})
+}
+
+// ClaimOutbox 实现 outbox.Store
+func (r *Repository) ClaimOutbox(ctx context.Context, batchSize, maxAttempts int32, lease time.Duration) ([]outbox.Message, error) {
+	rows, err := r.q.ClaimOutbox(ctx, eventdb.ClaimOutboxParams{
+		LeaseSeconds: int32(lease / time.Second),
+		MaxAttempts:  maxAttempts,
+		BatchSize:    batchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]outbox.Message, 0, len(rows))
+	for _, row := range rows {
+		msgs = append(msgs, outbox.Message{ID: row.ID, Kind: row.Kind, Payload: row.Payload, Attempts: row.Attempts})
+	}
+	return msgs, nil
+}
+
+func (r *Repository) CompleteOutbox(ctx context.Context, id int64) error {
+	return r.q.CompleteOutbox(ctx, id)
+}
+
+func (r *Repository) RetryOutbox(ctx context.Context, id int64, lastErr string, delay time.Duration) error {
+	return r.q.RetryOutbox(ctx, eventdb.RetryOutboxParams{
+		ID:           id,
+		LastError:    pgtype.Text{String: lastErr, Valid: true},
+		DelaySeconds: int32(delay / time.Second),
+	})
+}
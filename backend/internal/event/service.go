@@ -0,0 +1,465 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+	"github.com/shiqi/datai/backend/internal/bus"
+	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// 活动地点类型，对应 events.location_type 的 CHECK 约束
+const (
+	LocationOnline  = "online"
+	LocationOffline = "offline"
+	LocationHybrid  = "hybrid"
+)
+
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+var (
+	ErrTitleRequired                 = errors.New("event title is required")
+	ErrInvalidTimeRange              = errors.New("event end time must be after start time")
+	ErrStartTimeInPast               = errors.New("event start time must be in the future")
+	ErrInvalidLocationType           = errors.New("location type must be one of online, offline, hybrid")
+	ErrInvalidParticipantLimit       = errors.New("participant limit must be positive")
+	ErrParticipantLimitConflict      = errors.New("participant limit cannot be set and cleared at the same time")
+	ErrParticipantLimitBelowApproved = errors.New("participant limit cannot be lower than the number of approved participants")
+	ErrNotEventOwner                 = errors.New("only the event owner can modify this event")
+	ErrEventCancelled                = errors.New("event has been cancelled")
+)
+
+// Options 活动模块的可配置项，零值使用默认配置
+type Options struct {
+	CommentMaxDepth int     // 评论回复树最多展开的层数
+	Bus             bus.Bus // 活动变更和新评论的推送，为空时使用进程内的 Memory
+}
+
+type Service struct {
+	eventRepo   *Repository
+	userService *user.Service
+	opts        Options
+}
+
+func NewService(eventRepo *Repository, userService *user.Service, opts Options) *Service {
+	if opts.CommentMaxDepth <= 0 {
+		opts.CommentMaxDepth = defaultCommentMaxDepth
+	}
+	if opts.Bus == nil {
+		opts.Bus = bus.NewMemory()
+	}
+	return &Service{eventRepo: eventRepo, userService: userService, opts: opts}
+}
+
+type CreateEventInput struct {
+	OwnerUID         string
+	Title            string
+	Description      string
+	PrimaryTag       string
+	SecondaryTags    []string
+	StartTime        time.Time
+	EndTime          time.Time
+	LocationType     string
+	LocationDetail   string
+	CoverImage       string
+	RequireApproval  bool
+	ParticipantLimit *int32
+	TenantID         string // 为空时创建公开活动
+}
+
+// UpdateEventInput 中为 nil 的字段保持原值不变
+type UpdateEventInput struct {
+	EventID               int64
+	OwnerUID              string
+	Title                 *string
+	Description           *string
+	PrimaryTag            *string
+	SecondaryTags         []string
+	StartTime             *time.Time
+	EndTime               *time.Time
+	LocationType          *string
+	LocationDetail        *string
+	CoverImage            *string
+	RequireApproval       *bool
+	ParticipantLimit      *int32
+	ClearParticipantLimit bool // 取消名额限制，不能与 ParticipantLimit 同时设置
+}
+
+type ListEventsFilter struct {
+	OwnerID          *int64
+	GroupID          *int64
+	PrimaryTag       *string
+	LocationType     *string
+	StartsAfter      *time.Time
+	StartsBefore     *time.Time
+	IncludeCancelled bool
+	Limit            int32
+	Offset           int32
+}
+
+func (s *Service) CreateEvent(ctx context.Context, input CreateEventInput) (*eventdb.Event, error) {
+	title := strings.TrimSpace(input.Title)
+	if title == "" {
+		return nil, ErrTitleRequired
+	}
+	if err := validateSchedule(input.StartTime, input.EndTime); err != nil {
+		return nil, err
+	}
+	if !input.StartTime.After(time.Now()) {
+		return nil, ErrStartTimeInPast
+	}
+	if !isValidLocationType(input.LocationType) {
+		return nil, ErrInvalidLocationType
+	}
+	if input.ParticipantLimit != nil && *input.ParticipantLimit <= 0 {
+		return nil, ErrInvalidParticipantLimit
+	}
+
+	// 活动发起人只能是当前登录用户
+	owner, err := s.userService.GetUserByUID(ctx, input.OwnerUID)
+	if err != nil {
+		return nil, err
+	}
+
+	secondaryTags, err := encodeTags(input.SecondaryTags)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.eventRepo.CreateEvent(ctx, eventdb.CreateEventParams{
+		OwnerID:          owner.ID,
+		OwnerNickname:    owner.Nickname,
+		Title:            title,
+		Description:      textOrNull(input.Description),
+		PrimaryTag:       textOrNull(input.PrimaryTag),
+		SecondaryTags:    secondaryTags,
+		StartTime:        pgtype.Timestamptz{Time: input.StartTime, Valid: true},
+		EndTime:          pgtype.Timestamptz{Time: input.EndTime, Valid: true},
+		LocationType:     input.LocationType,
+		LocationDetail:   textOrNull(input.LocationDetail),
+		CoverImage:       textOrNull(input.CoverImage),
+		RequireApproval:  pgtype.Bool{Bool: input.RequireApproval, Valid: true},
+		ParticipantLimit: int4OrNull(input.ParticipantLimit),
+		TenantID:         textOrNull(input.TenantID),
+	})
+}
+
+func (s *Service) UpdateEvent(ctx context.Context, input UpdateEventInput) (*eventdb.Event, error) {
+	if input.ParticipantLimit != nil {
+		if input.ClearParticipantLimit {
+			return nil, ErrParticipantLimitConflict
+		}
+		if *input.ParticipantLimit <= 0 {
+			return nil, ErrInvalidParticipantLimit
+		}
+	}
+	owner, err := s.userService.GetUserByUID(ctx, input.OwnerUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *eventdb.Event
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		// 锁定后再读取原值，避免覆盖并发的修改或取消，也避免与并发报名抢占名额
+		existing, err := txRepo.lockOwnedEvent(ctx, input.EventID, owner.ID)
+		if err != nil {
+			return err
+		}
+		if existing.CancelledAt.Valid {
+			return ErrEventCancelled
+		}
+		arg, err := mergeEventUpdate(existing, input)
+		if err != nil {
+			return err
+		}
+
+		limitChanged := arg.ParticipantLimit != existing.ParticipantLimit
+		if limitChanged && arg.ParticipantLimit.Valid {
+			approved, err := txRepo.CountActiveParticipants(ctx, existing.ID)
+			if err != nil {
+				return err
+			}
+			if approved > int64(arg.ParticipantLimit.Int32) {
+				return ErrParticipantLimitBelowApproved
+			}
+		}
+
+		if result, err = txRepo.UpdateEvent(ctx, arg); err != nil {
+			return err
+		}
+		if !limitChanged {
+			return nil
+		}
+		// 调高或取消名额限制后按候补顺序递补
+		if err := txRepo.promoteWaitlisted(ctx, result); err != nil {
+			return err
+		}
+		return txRepo.refreshEventGroup(ctx, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, result.ID)
+	return result, nil
+}
+
+// mergeEventUpdate 以原值为基础合并本次修改
+func mergeEventUpdate(existing *eventdb.Event, input UpdateEventInput) (eventdb.UpdateEventParams, error) {
+	arg := eventdb.UpdateEventParams{
+		ID:               existing.ID,
+		Title:            existing.Title,
+		Description:      existing.Description,
+		PrimaryTag:       existing.PrimaryTag,
+		SecondaryTags:    existing.SecondaryTags,
+		StartTime:        existing.StartTime,
+		EndTime:          existing.EndTime,
+		LocationType:     existing.LocationType,
+		LocationDetail:   existing.LocationDetail,
+		CoverImage:       existing.CoverImage,
+		RequireApproval:  existing.RequireApproval,
+		ParticipantLimit: existing.ParticipantLimit,
+	}
+	if input.Title != nil {
+		title := strings.TrimSpace(*input.Title)
+		if title == "" {
+			return arg, ErrTitleRequired
+		}
+		arg.Title = title
+	}
+	if input.Description != nil {
+		arg.Description = textOrNull(*input.Description)
+	}
+	if input.PrimaryTag != nil {
+		arg.PrimaryTag = textOrNull(*input.PrimaryTag)
+	}
+	if input.SecondaryTags != nil {
+		tags, err := encodeTags(input.SecondaryTags)
+		if err != nil {
+			return arg, err
+		}
+		arg.SecondaryTags = tags
+	}
+	if input.StartTime != nil {
+		if !input.StartTime.After(time.Now()) {
+			return arg, ErrStartTimeInPast
+		}
+		arg.StartTime = pgtype.Timestamptz{Time: *input.StartTime, Valid: true}
+	}
+	if input.EndTime != nil {
+		arg.EndTime = pgtype.Timestamptz{Time: *input.EndTime, Valid: true}
+	}
+	if err := validateSchedule(arg.StartTime.Time, arg.EndTime.Time); err != nil {
+		return arg, err
+	}
+	if input.LocationType != nil {
+		if !isValidLocationType(*input.LocationType) {
+			return arg, ErrInvalidLocationType
+		}
+		arg.LocationType = *input.LocationType
+	}
+	if input.LocationDetail != nil {
+		arg.LocationDetail = textOrNull(*input.LocationDetail)
+	}
+	if input.CoverImage != nil {
+		arg.CoverImage = textOrNull(*input.CoverImage)
+	}
+	if input.RequireApproval != nil {
+		arg.RequireApproval = pgtype.Bool{Bool: *input.RequireApproval, Valid: true}
+	}
+	switch {
+	case input.ClearParticipantLimit:
+		arg.ParticipantLimit = pgtype.Int4{}
+	case input.ParticipantLimit != nil:
+		arg.ParticipantLimit = int4OrNull(input.ParticipantLimit)
+	}
+	return arg, nil
+}
+
+func (s *Service) CancelEvent(ctx context.Context, eventID int64, ownerUID, reason string) (*eventdb.Event, error) {
+	existing, err := s.getOwnedEvent(ctx, eventID, ownerUID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.CancelledAt.Valid {
+		return nil, ErrEventCancelled
+	}
+
+	var result *eventdb.Event
+	err = s.eventRepo.WithTx(ctx, func(txRepo *Repository) error {
+		if result, err = txRepo.CancelEvent(ctx, eventdb.CancelEventParams{
+			ID:           existing.ID,
+			CancelReason: textOrNull(reason),
+		}); err != nil {
+			return err
+		}
+		return txRepo.refreshEventGroup(ctx, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishEventUpdated(ctx, result.ID)
+	return result, nil
+}
+
+// GetEventByID 读取活动；调用者不属于活动所在租户时按不存在处理
+func (s *Service) GetEventByID(ctx context.Context, id int64) (*eventdb.Event, error) {
+	event, err := s.eventRepo.GetEventByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canSeeEvent(ctx, event) {
+		return nil, pgx.ErrNoRows
+	}
+	return event, nil
+}
+
+func (s *Service) ListEvents(ctx context.Context, filter ListEventsFilter) ([]eventdb.Event, error) {
+	if filter.LocationType != nil && !isValidLocationType(*filter.LocationType) {
+		return nil, ErrInvalidLocationType
+	}
+
+	limit, offset := normalizePage(filter.Limit, filter.Offset)
+
+	arg := eventdb.ListEventsParams{
+		IncludeCancelled: filter.IncludeCancelled,
+		TenantIds:        visibleTenantIDs(ctx),
+		Limit:            limit,
+		Offset:           offset,
+	}
+	if filter.OwnerID != nil {
+		arg.OwnerID = pgtype.Int8{Int64: *filter.OwnerID, Valid: true}
+	}
+	if filter.GroupID != nil {
+		arg.GroupID = pgtype.Int8{Int64: *filter.GroupID, Valid: true}
+	}
+	if filter.PrimaryTag != nil {
+		arg.PrimaryTag = pgtype.Text{String: *filter.PrimaryTag, Valid: true}
+	}
+	if filter.LocationType != nil {
+		arg.LocationType = pgtype.Text{String: *filter.LocationType, Valid: true}
+	}
+	if filter.StartsAfter != nil {
+		arg.StartsAfter = pgtype.Timestamptz{Time: *filter.StartsAfter, Valid: true}
+	}
+	if filter.StartsBefore != nil {
+		arg.StartsBefore = pgtype.Timestamptz{Time: *filter.StartsBefore, Valid: true}
+	}
+
+	return s.eventRepo.ListEvents(ctx, arg)
+}
+
+// CheckEventOwner 确认 uid 是活动发起人，供 @owner 指令使用
+func (s *Service) CheckEventOwner(ctx context.Context, eventID int64, uid string) error {
+	_, err := s.getOwnedEvent(ctx, eventID, uid)
+	return err
+}
+
+// getOwnedEvent 读取活动并确认当前用户是发起人
+func (s *Service) getOwnedEvent(ctx context.Context, eventID int64, ownerUID string) (*eventdb.Event, error) {
+	owner, err := s.userService.GetUserByUID(ctx, ownerUID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.eventRepo.GetEventByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.OwnerID != owner.ID {
+		return nil, ErrNotEventOwner
+	}
+	return existing, nil
+}
+
+// lockOwnedEvent 在事务内锁定活动行并确认 ownerID 是发起人
+func (r *Repository) lockOwnedEvent(ctx context.Context, eventID, ownerID int64) (*eventdb.Event, error) {
+	event, err := r.GetEventForUpdate(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.OwnerID != ownerID {
+		return nil, ErrNotEventOwner
+	}
+	return event, nil
+}
+
+// visibleTenantIDs 当前请求可以看到的租户活动范围，公开活动（tenant_id 为空）总是可见
+func visibleTenantIDs(ctx context.Context) []string {
+	ids := middleware.GetTenantIDsFromContext(ctx)
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
+
+func canSeeEvent(ctx context.Context, event *eventdb.Event) bool {
+	return !event.TenantID.Valid || slices.Contains(middleware.GetTenantIDsFromContext(ctx), event.TenantID.String)
+}
+
+func normalizePage(limit, offset int32) (int32, int32) {
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+func validateSchedule(start, end time.Time) error {
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		return ErrInvalidTimeRange
+	}
+	return nil
+}
+
+func isValidLocationType(locationType string) bool {
+	switch locationType {
+	case LocationOnline, LocationOffline, LocationHybrid:
+		return true
+	}
+	return false
+}
+
+func encodeTags(tags []string) ([]byte, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(tags)
+}
+
+// DecodeTags 解析 JSONB 标签列，列为空时返回空切片
+func DecodeTags(raw []byte) []string {
+	var tags []string
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &tags)
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+	return tags
+}
+
+func textOrNull(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+func int4OrNull(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
@@ -0,0 +1,68 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+	"github.com/shiqi/datai/backend/internal/bus"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// 活动变更和新评论的推送，消息只带 ID，订阅方收到后按自己的权限重新查询
+
+// EventTopic 活动信息、报名人数等变更的推送主题
+func EventTopic(eventID int64) string {
+	return fmt.Sprintf("event:%d", eventID)
+}
+
+// CommentTopic 活动新评论的推送主题
+func CommentTopic(eventID int64) string {
+	return fmt.Sprintf("event_comment:%d", eventID)
+}
+
+// SubscribeEvent 推送活动的最新状态；订阅时调用者必须能看到该活动
+func (s *Service) SubscribeEvent(ctx context.Context, eventID int64) (<-chan *eventdb.Event, error) {
+	if _, err := s.GetEventByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+	return bus.Relay(ctx, s.opts.Bus, EventTopic(eventID), s.getEventIfVisible)
+}
+
+// SubscribeComments 推送活动下新发表的评论和回复
+func (s *Service) SubscribeComments(ctx context.Context, eventID int64) (<-chan *eventdb.EventComment, error) {
+	if _, err := s.GetEventByID(ctx, eventID); err != nil {
+		return nil, err
+	}
+	return bus.Relay(ctx, s.opts.Bus, CommentTopic(eventID), func(ctx context.Context, id int64) (*eventdb.EventComment, error) {
+		comment, err := s.eventRepo.GetCommentByID(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return comment, err
+	})
+}
+
+// getEventIfVisible 活动不存在或已不可见时返回 nil，订阅方跳过该消息
+func (s *Service) getEventIfVisible(ctx context.Context, eventID int64) (*eventdb.Event, error) {
+	event, err := s.GetEventByID(ctx, eventID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return event, err
+}
+
+// 推送失败只记录日志，不影响已经提交的写操作
+func (s *Service) publishEventUpdated(ctx context.Context, eventID int64) {
+	if err := bus.PublishID(ctx, s.opts.Bus, EventTopic(eventID), eventID); err != nil {
+		middleware.Logger(ctx).Warn("failed to publish event update", "event_id", eventID, "error", err)
+	}
+}
+
+func (s *Service) publishCommentAdded(ctx context.Context, comment *eventdb.EventComment) {
+	if err := bus.PublishID(ctx, s.opts.Bus, CommentTopic(comment.EventID), comment.ID); err != nil {
+		middleware.Logger(ctx).Warn("failed to publish comment", "comment_id", comment.ID, "event_id", comment.EventID, "error", err)
+	}
+}
@@ -0,0 +1,130 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shiqi/datai/backend/internal/migration"
+)
+
+// 存活和就绪探针：/healthz 只表示进程在运行，/readyz 检查每个数据库的连通性和迁移版本
+
+const checkTimeout = 2 * time.Second
+
+// Database 需要检查的数据库
+type Database struct {
+	Name   string
+	Pool   *pgxpool.Pool
+	Latest uint // 迁移目录中最新的版本，当前版本落后时视为未就绪
+}
+
+// DatabaseStatus 单个数据库的检查结果
+type DatabaseStatus struct {
+	Status  string `json:"status"`
+	Version uint   `json:"version"`
+	Latest  uint   `json:"latest"`
+	Dirty   bool   `json:"dirty"`
+	Error   string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status    string                    `json:"status"`
+	Databases map[string]DatabaseStatus `json:"databases,omitempty"`
+}
+
+type Checker struct {
+	dbs      []Database
+	draining atomic.Bool
+}
+
+func NewChecker(dbs []Database) *Checker {
+	return &Checker{dbs: dbs}
+}
+
+// SetDraining 开始优雅退出，之后 /readyz 返回 503，让负载均衡不再转发新请求
+func (c *Checker) SetDraining() {
+	c.draining.Store(true)
+}
+
+// Liveness /healthz
+func (c *Checker) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, Report{Status: "ok"})
+}
+
+// Readiness /readyz，任一数据库不可用、迁移 dirty 或未迁移到最新版本时返回 503
+func (c *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	if c.draining.Load() {
+		writeReport(w, http.StatusServiceUnavailable, Report{Status: "draining"})
+		return
+	}
+
+	report := c.Check(r.Context())
+	code := http.StatusOK
+	if report.Status != "ok" {
+		code = http.StatusServiceUnavailable
+	}
+	writeReport(w, code, report)
+}
+
+// Check 并发检查全部数据库
+func (c *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	statuses := make([]DatabaseStatus, len(c.dbs))
+	var wg sync.WaitGroup
+	for i, db := range c.dbs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = checkDatabase(ctx, db)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: "ok", Databases: make(map[string]DatabaseStatus, len(c.dbs))}
+	for i, db := range c.dbs {
+		if statuses[i].Status != "ok" {
+			report.Status = "unavailable"
+		}
+		report.Databases[db.Name] = statuses[i]
+	}
+	return report
+}
+
+func checkDatabase(ctx context.Context, db Database) DatabaseStatus {
+	status := DatabaseStatus{Status: "ok", Latest: db.Latest}
+	if err := db.Pool.Ping(ctx); err != nil {
+		status.Status = "unavailable"
+		status.Error = err.Error()
+		return status
+	}
+
+	version, dirty, err := migration.CurrentVersion(ctx, db.Pool)
+	if err != nil {
+		status.Status = "unavailable"
+		status.Error = err.Error()
+		return status
+	}
+	status.Version = version
+	status.Dirty = dirty
+	switch {
+	case dirty:
+		status.Status = "dirty"
+	case version < db.Latest:
+		status.Status = "pending_migrations"
+	}
+	return status
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(report)
+}
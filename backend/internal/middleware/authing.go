@@ -2,14 +2,10 @@ package middleware
 
 import (
 	"context"
-	"crypto/rsa"
-	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"math/big"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -18,7 +14,7 @@ import (
 )
 
 // 解析 Authorization: Bearer <token> 头部
-// 验证这个 token 是不是 Authing 签发的
+// 按 token 的 iss 找到受信任的签发方（Authing、学校 SSO 等），用它的 JWKS 或密钥验证签名
 // 提取其中的 sub 或 userId
 // 注入到 context.Context，供后续 GraphQL 使用
 
@@ -34,295 +30,256 @@ func GetUserIDFromContext(ctx context.Context) (string, error) {
 	return "", errors.New("authing user id not found in context")
 }
 
-type AuthingMiddleware struct {
-	JWKSURL      string // Authing 的公钥地址，例如：https://<your-authing-domain>/.well-known/jwks.json
-	Audience     string // 你在 Authing 设置的 API Identifier
-	Issuer       string // Authing 的签发者，例如：https://<your-authing-domain>/
-	ClientSecret string // Authing 的客户端密钥，用于 HS256 算法
+var (
+	// asymmetricAlgorithms 通过 JWKS 公钥校验的算法
+	asymmetricAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+	// hmacAlgorithms 通过 ClientSecret 校验的算法
+	hmacAlgorithms = []string{"HS256", "HS384", "HS512"}
+)
 
-	// 缓存相关
-	keysCache  map[string]interface{} // 支持 RSA 公钥和 HMAC 密钥
-	cacheMutex sync.RWMutex
-	lastFetch  time.Time
-	cacheTTL   time.Duration
+// IssuerConfig 一个受信任的签发方
+type IssuerConfig struct {
+	Issuer        string   // token 的 iss，例如：https://<your-authing-domain>/oidc；只配置一个签发方时可以为空，表示不校验 iss
+	JWKSURL       string   // 公钥地址，例如：https://<your-authing-domain>/oidc/.well-known/jwks.json
+	Audiences     []string // token 的 aud 包含其中任意一个即可，为空不校验
+	ClientSecret  string   // HMAC 签名密钥，用于 HS256 等算法
+	Algorithms    []string // 允许的签名算法，为空时按配置了 JWKS 还是密钥推断
+	SubjectPrefix string   // 加在 sub 前作为用户 ID，避免不同签发方的 sub 冲突
 }
 
-// JWKS 结构
-type JWKS struct {
-	Keys []JWK `json:"keys"`
+type issuer struct {
+	IssuerConfig
+	keys *keySet // 未配置 JWKS 时为 nil
 }
 
-type JWK struct {
-	Kid string `json:"kid"`
-	Kty string `json:"kty"`
-	Alg string `json:"alg"`
-	Use string `json:"use"`
-	N   string `json:"n"`
-	E   string `json:"e"`
+type AuthingMiddleware struct {
+	Optional  bool           // 可选认证：没有 token 的请求以匿名身份放行，带了 token 但无效仍然拒绝
+	ClockSkew time.Duration  // 校验 exp、nbf、iat 时容忍的时钟偏差
+	APIKeys   APIKeyVerifier // 为 nil 时不接受 API key
+	// Sessions 为 nil 时不检查会话是否已撤销
+	Sessions        SessionRegistry
+	SessionCacheTTL time.Duration // 会话撤销状态的缓存时间，其他实例上的撤销最多延迟这么久生效
+
+	issuers      []*issuer
+	sessionCache sessionCache
 }
 
-func NewAuthingMiddleware(jwksURL, audience, issuer, clientSecret string) *AuthingMiddleware {
-	return &AuthingMiddleware{
-		JWKSURL:      jwksURL,
-		Audience:     audience,
-		Issuer:       issuer,
-		ClientSecret: clientSecret,
-		keysCache:    make(map[string]interface{}),
-		cacheTTL:     1 * time.Hour, // 缓存1小时
+// NewAuthingMiddleware 按签发方配置创建认证中间件，配置不合法时返回错误
+func NewAuthingMiddleware(issuers ...IssuerConfig) (*AuthingMiddleware, error) {
+	if len(issuers) == 0 {
+		return nil, errors.New("at least one trusted issuer is required")
 	}
-}
-
-func (a *AuthingMiddleware) Middleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// 添加请求日志
-		log.Printf("🔐 Authing 中间件收到请求: %s %s", r.Method, r.URL.Path)
-
-		// 提取 Bearer Token
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			log.Printf("❌ 缺少或无效的 Authorization 头部")
-			http.Error(w, "missing or invalid Authorization header", http.StatusUnauthorized)
-			return
-		}
-		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-		log.Printf("📝 提取到 Token: %s...", tokenStr[:50])
-
-		// 解析JWT获取kid和算法
-		log.Printf("🔍 开始解析 JWT 获取 kid 和算法...")
-
-		// 手动解析 JWT header 部分来获取 kid 和算法，避免签名验证
-		parts := strings.Split(tokenStr, ".")
-		if len(parts) != 3 {
-			log.Printf("❌ JWT 格式错误：应该包含3个部分")
-			http.Error(w, "invalid token format", http.StatusUnauthorized)
-			return
-		}
-
-		// 解码 header 部分
-		headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
-		if err != nil {
-			log.Printf("❌ JWT header 解码失败: %v", err)
-			http.Error(w, "invalid token format", http.StatusUnauthorized)
-			return
-		}
 
-		var header map[string]interface{}
-		if err := json.Unmarshal(headerBytes, &header); err != nil {
-			log.Printf("❌ JWT header 解析失败: %v", err)
-			http.Error(w, "invalid token format", http.StatusUnauthorized)
-			return
+	a := &AuthingMiddleware{}
+	seen := make(map[string]bool, len(issuers))
+	for _, cfg := range issuers {
+		if cfg.Issuer == "" && len(issuers) > 1 {
+			return nil, errors.New("issuer must be set when trusting multiple issuers")
 		}
-
-		log.Printf("✅ JWT header 解析成功")
-
-		// 获取算法
-		alg, ok := header["alg"].(string)
-		if !ok {
-			log.Printf("❌ 算法未在 token header 中找到")
-			http.Error(w, "algorithm not found in token header", http.StatusUnauthorized)
-			return
+		if seen[cfg.Issuer] {
+			return nil, fmt.Errorf("duplicate issuer %q", cfg.Issuer)
 		}
-		log.Printf("🔍 找到算法: %s", alg)
-
-		// 获取kid（可能不存在）
-		kid, hasKid := header["kid"].(string)
-		if !hasKid {
-			log.Printf("⚠️ kid 未在 token header 中找到，将使用默认密钥")
-		} else {
-			log.Printf("🔑 找到 kid: %s", kid)
+		seen[cfg.Issuer] = true
+		if cfg.JWKSURL == "" && cfg.ClientSecret == "" {
+			return nil, fmt.Errorf("issuer %q: jwks url or client secret is required", cfg.Issuer)
 		}
 
-		// 获取对应的密钥
-		var signingKey interface{}
-		if hasKid {
-			signingKey, err = a.getSigningKey(kid)
-			if err != nil {
-				log.Printf("❌ 获取密钥失败: %v", err)
-				http.Error(w, "failed to get signing key", http.StatusUnauthorized)
-				return
+		if len(cfg.Algorithms) == 0 {
+			if cfg.JWKSURL != "" {
+				cfg.Algorithms = append(cfg.Algorithms, asymmetricAlgorithms...)
 			}
-		} else {
-			// 对于没有 kid 的 token，尝试获取默认密钥
-			signingKey, err = a.getDefaultSigningKey(alg)
-			if err != nil {
-				log.Printf("❌ 获取默认密钥失败: %v", err)
-				http.Error(w, "failed to get default signing key", http.StatusUnauthorized)
-				return
+			if cfg.ClientSecret != "" {
+				cfg.Algorithms = append(cfg.Algorithms, hmacAlgorithms...)
 			}
 		}
-		log.Printf("✅ 获取密钥成功")
-
-		// 验证token
-		log.Printf("🔐 开始验证 token...")
-		validatedToken, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-			log.Printf("🔍 验证 token 算法: %s", token.Method.Alg())
-			if token.Method.Alg() != "RS256" && token.Method.Alg() != "HS256" {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Method.Alg())
+		for _, alg := range cfg.Algorithms {
+			switch {
+			case slices.Contains(asymmetricAlgorithms, alg):
+				if cfg.JWKSURL == "" {
+					return nil, fmt.Errorf("issuer %q: algorithm %s requires a jwks url", cfg.Issuer, alg)
+				}
+			case slices.Contains(hmacAlgorithms, alg):
+				if cfg.ClientSecret == "" {
+					return nil, fmt.Errorf("issuer %q: algorithm %s requires a client secret", cfg.Issuer, alg)
+				}
+			default:
+				return nil, fmt.Errorf("issuer %q: unsupported algorithm %q", cfg.Issuer, alg)
 			}
-			return signingKey, nil
-		})
-		if err != nil || !validatedToken.Valid {
-			log.Printf("❌ 验证 token 失败: %v", err)
-			http.Error(w, "invalid token", http.StatusUnauthorized)
-			return
 		}
-		log.Printf("✅ Token 验证成功")
 
-		// 验证claims
-		claims, ok := validatedToken.Claims.(jwt.MapClaims)
-		if !ok {
-			log.Printf("❌ 无效的 token claims")
-			http.Error(w, "invalid token claims", http.StatusUnauthorized)
-			return
+		iss := &issuer{IssuerConfig: cfg}
+		if cfg.JWKSURL != "" {
+			iss.keys = newKeySet(cfg.JWKSURL)
 		}
-		log.Printf("✅ Claims 验证成功")
+		a.issuers = append(a.issuers, iss)
+	}
+	return a, nil
+}
 
-		// 验证audience
-		if a.Audience != "" {
-			if aud, ok := claims["aud"].(string); !ok || aud != a.Audience {
-				log.Printf("❌ 无效的 audience: %s (期望: %s)", aud, a.Audience)
-				http.Error(w, "invalid audience", http.StatusUnauthorized)
-				return
-			}
-			log.Printf("✅ Audience 验证成功")
-		}
+func (a *AuthingMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 登记会话时记录客户端信息，WebSocket 的 connection_init 也会用到
+		r = r.WithContext(withClientInfo(r.Context(), r))
 
-		// 验证issuer
-		if a.Issuer != "" {
-			if iss, ok := claims["iss"].(string); !ok || iss != a.Issuer {
-				log.Printf("❌ 无效的 issuer: %s (期望: %s)", iss, a.Issuer)
-				http.Error(w, "invalid issuer", http.StatusUnauthorized)
-				return
-			}
-			log.Printf("✅ Issuer 验证成功")
+		// WebSocket 握手无法携带 Authorization 头部，token 在 connection_init 中由 WebSocketInit 校验
+		if IsWebSocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
 		}
 
-		// 验证过期时间
-		if exp, ok := claims["exp"].(float64); ok {
-			if time.Now().Unix() > int64(exp) {
-				log.Printf("❌ Token 已过期")
-				http.Error(w, "token expired", http.StatusUnauthorized)
-				return
-			}
-			log.Printf("✅ 过期时间验证成功")
+		// 提取 Bearer Token
+		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" && a.Optional {
+			// 匿名访问，context 中不注入用户，由指令和 resolver 决定是否需要登录
+			next.ServeHTTP(w, r)
+			return
 		}
-
-		// 提取用户 ID（sub 或自定义字段）
-		userID, ok := claims["sub"].(string)
-		if !ok || userID == "" {
-			log.Printf("❌ 用户 ID (sub) 未在 token 中找到")
-			http.Error(w, "user ID not found in token", http.StatusUnauthorized)
+		if !strings.HasPrefix(authHeader, "Bearer ") {
+			http.Error(w, "missing or invalid Authorization header", http.StatusUnauthorized)
 			return
 		}
-		log.Printf("👤 提取用户 ID: %s", userID)
+		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
 
-		// 注入到 context
-		ctx := context.WithValue(r.Context(), userIdKey, userID)
+		ctx, err := a.Authenticate(r.Context(), tokenStr)
+		if err != nil {
+			// 只记录失败原因，不记录 token
+			Logger(r.Context()).Debug("authentication failed", "error", err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-func (a *AuthingMiddleware) getSigningKey(kid string) (interface{}, error) {
-	// 先从缓存获取
-	a.cacheMutex.RLock()
-	if key, exists := a.keysCache[kid]; exists {
-		a.cacheMutex.RUnlock()
-		return key, nil
+// Run 在后台刷新各签发方的 JWKS，直到 ctx 被取消
+func (a *AuthingMiddleware) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, iss := range a.issuers {
+		if iss.keys == nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			iss.keys.Run(ctx)
+		}()
 	}
-	a.cacheMutex.RUnlock()
+	wg.Wait()
+}
 
-	// 检查是否需要刷新缓存
-	a.cacheMutex.Lock()
-	defer a.cacheMutex.Unlock()
+// Authenticate 校验 token，把用户 ID 和 token 中声明的租户注入 context
+func (a *AuthingMiddleware) Authenticate(ctx context.Context, tokenStr string) (context.Context, error) {
+	if IsAPIKey(tokenStr) {
+		return a.authenticateAPIKey(ctx, tokenStr)
+	}
 
-	if time.Since(a.lastFetch) > a.cacheTTL {
-		// 刷新缓存
-		if err := a.refreshKeysCache(); err != nil {
-			return nil, err
-		}
+	// access token 必须有 exp，防止不过期的 token 被当作凭证长期使用
+	claims, iss, err := a.parse(tokenStr, jwt.WithExpirationRequired())
+	switch {
+	case errors.Is(err, errUntrustedIssuer):
+		return nil, errors.New("invalid issuer")
+	case errors.Is(err, jwt.ErrTokenRequiredClaimMissing):
+		return nil, errors.New("token has no expiration")
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return nil, errors.New("token expired")
+	case errors.Is(err, jwt.ErrTokenNotValidYet), errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return nil, errors.New("token not valid yet")
+	case errors.Is(err, errInvalidAudience):
+		return nil, errInvalidAudience
+	case err != nil:
+		// 具体原因（签名错误、获取公钥失败等）只写日志，不返回给客户端
+		Logger(ctx).Debug("token verification failed", "error", err)
+		return nil, errors.New("invalid token")
+	}
+	// 同一签发方签发的 logout_token 签名有效，但不能当作 access token 使用
+	if isLogoutToken(claims) {
+		return nil, errors.New("logout token cannot be used for authentication")
 	}
 
-	// 再次从缓存获取
-	if key, exists := a.keysCache[kid]; exists {
-		return key, nil
+	// 提取用户 ID（sub 或自定义字段）
+	userID, ok := claims["sub"].(string)
+	if !ok || userID == "" {
+		return nil, errors.New("user ID not found in token")
 	}
+	userID = iss.SubjectPrefix + userID
 
-	return nil, fmt.Errorf("key not found: %s", kid)
+	session := sessionFromClaims(ctx, claims, userID)
+	if a.Sessions != nil {
+		if err := a.checkSession(ctx, session); err != nil {
+			return nil, err
+		}
+	}
+	ctx = context.WithValue(ctx, sessionKey, session)
+
+	// 注入到 context，并记录到请求日志
+	ctx = context.WithValue(ctx, userIdKey, userID)
+	setLogUser(ctx, userID)
+	// token 中声明的租户只作为候选，是否生效由 TenantMiddleware 校验
+	if tenantID, ok := claims[TenantClaim].(string); ok && tenantID != "" {
+		ctx = context.WithValue(ctx, tenantClaimKey, tenantID)
+	}
+	return ctx, nil
 }
 
-func (a *AuthingMiddleware) refreshKeysCache() error {
-	// 获取JWKS
-	resp, err := http.Get(a.JWKSURL)
+var (
+	errUntrustedIssuer = errors.New("untrusted issuer")
+	errInvalidAudience = errors.New("invalid audience")
+)
+
+// parse 校验 token 的签名、签发方和 audience：先按未验证的 iss 选出签发方，再用它的密钥验证签名；
+// exp、nbf、iat 由 jwt 库按 ClockSkew 校验，opts 追加调用方需要的校验
+func (a *AuthingMiddleware) parse(tokenStr string, opts ...jwt.ParserOption) (jwt.MapClaims, *issuer, error) {
+	var iss *issuer
+	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
+		var err error
+		if iss, err = a.issuerFor(token.Claims); err != nil {
+			return nil, err
+		}
+		return iss.signingKey(token)
+	}, append([]jwt.ParserOption{jwt.WithLeeway(a.ClockSkew), jwt.WithIssuedAt()}, opts...)...)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
-
-	var jwks JWKS
-	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
-		return err
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, nil, errors.New("invalid token claims")
 	}
 
-	// 清空旧缓存
-	a.keysCache = make(map[string]interface{})
-
-	// 转换并缓存所有key
-	for _, key := range jwks.Keys {
-		if key.Kty == "RSA" && key.Use == "sig" {
-			rsaKey, err := jwkToRSAPublicKey(key)
-			if err != nil {
-				continue // 跳过无效的key
-			}
-			a.keysCache[key.Kid] = rsaKey
+	// 验证audience，aud 可以是字符串或数组
+	if len(iss.Audiences) > 0 {
+		aud, err := claims.GetAudience()
+		if err != nil || !slices.ContainsFunc(aud, func(v string) bool { return slices.Contains(iss.Audiences, v) }) {
+			return nil, nil, errInvalidAudience
 		}
 	}
-
-	a.lastFetch = time.Now()
-	return nil
+	return claims, iss, nil
 }
 
-func (a *AuthingMiddleware) getDefaultSigningKey(alg string) (interface{}, error) {
-	// 对于 HS256 算法，使用 client_secret 作为对称密钥
-	if alg == "HS256" {
-		if a.ClientSecret == "" {
-			return nil, fmt.Errorf("HS256 algorithm requires client_secret to be configured")
+// issuerFor 按 iss 查找签发方；只配置了一个且未指定 iss 的签发方时接受任意 iss
+func (a *AuthingMiddleware) issuerFor(claims jwt.Claims) (*issuer, error) {
+	iss, _ := claims.GetIssuer()
+	for _, i := range a.issuers {
+		if i.Issuer != "" && i.Issuer == iss {
+			return i, nil
 		}
-		log.Printf("🔑 使用 client_secret 作为 HS256 签名密钥")
-		return []byte(a.ClientSecret), nil
 	}
-
-	// 对于其他算法，尝试获取第一个可用的密钥
-	a.cacheMutex.RLock()
-	defer a.cacheMutex.RUnlock()
-
-	for _, key := range a.keysCache {
-		return key, nil
+	if len(a.issuers) == 1 && a.issuers[0].Issuer == "" {
+		return a.issuers[0], nil
 	}
-
-	return nil, fmt.Errorf("no signing keys available")
+	return nil, errUntrustedIssuer
 }
 
-func jwkToRSAPublicKey(jwk JWK) (*rsa.PublicKey, error) {
-	// 解码N和E
-	nBytes, err := base64.RawURLEncoding.DecodeString(jwk.N)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode N: %w", err)
+// signingKey 返回校验 token 签名的密钥，算法必须在签发方允许的范围内
+func (i *issuer) signingKey(token *jwt.Token) (any, error) {
+	alg := token.Method.Alg()
+	if !slices.Contains(i.Algorithms, alg) {
+		return nil, fmt.Errorf("unexpected signing method: %v", alg)
 	}
-
-	eBytes, err := base64.RawURLEncoding.DecodeString(jwk.E)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode E: %w", err)
-	}
-
-	// 转换为big.Int
-	n := new(big.Int).SetBytes(nBytes)
-	e := new(big.Int).SetBytes(eBytes)
-
-	// 创建RSA公钥
-	publicKey := &rsa.PublicKey{
-		N: n,
-		E: int(e.Int64()),
+	if slices.Contains(hmacAlgorithms, alg) {
+		return []byte(i.ClientSecret), nil
 	}
 
-	return publicKey, nil
+	// kid 可能不存在，不存在时使用第一个匹配算法的公钥
+	kid, _ := token.Header["kid"].(string)
+	return i.keys.get(kid, alg)
 }
@@ -0,0 +1,517 @@
+package middleware
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer    = "https://authing.example/oidc"
+	testSSOIssuer = "https://sso.example.edu"
+	testAudience  = "datai"
+	testSecret    = "sso-shared-secret-0123456789abcdef"
+)
+
+// testKeys 测试用的 RSA、EC（P-256）和 Ed25519 密钥，整个包共用一份，避免重复生成 RSA 密钥
+var testKeys = struct {
+	rsa     *rsa.PrivateKey
+	ec      *ecdsa.PrivateKey
+	ed      ed25519.PrivateKey
+	rsaOnly *rsa.PrivateKey // 不在 JWKS 中发布，模拟签发方轮换后的新密钥
+}{
+	rsa:     mustRSAKey(),
+	ec:      mustECKey(),
+	ed:      mustEd25519Key(),
+	rsaOnly: mustRSAKey(),
+}
+
+func mustRSAKey() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func mustECKey() *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func mustEd25519Key() ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// jwkFor 把公钥编码成 JWK
+func jwkFor(kid, alg string, pub crypto.PublicKey) JWK {
+	b64 := base64.RawURLEncoding.EncodeToString
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return JWK{Kid: kid, Kty: "RSA", Alg: alg, Use: "sig", N: b64(k.N.Bytes()), E: b64(big.NewInt(int64(k.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return JWK{Kid: kid, Kty: "EC", Alg: alg, Use: "sig", Crv: k.Curve.Params().Name,
+			X: b64(k.X.FillBytes(make([]byte, size))), Y: b64(k.Y.FillBytes(make([]byte, size)))}
+	case ed25519.PublicKey:
+		return JWK{Kid: kid, Kty: "OKP", Alg: alg, Use: "sig", Crv: "Ed25519", X: b64(k)}
+	}
+	panic("unsupported key type")
+}
+
+// jwksServer 提供 JWKS 的 httptest 服务，记录请求次数，keys 可以在测试中替换
+type jwksServer struct {
+	*httptest.Server
+	requests atomic.Int32
+	keys     atomic.Pointer[JWKS]
+	status   atomic.Int32 // 非 0 时返回该状态码
+}
+
+func newJWKSServer(t *testing.T, keys ...JWK) *jwksServer {
+	t.Helper()
+	s := &jwksServer{}
+	s.setKeys(keys...)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests.Add(1)
+		if status := s.status.Load(); status != 0 {
+			w.WriteHeader(int(status))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "max-age=300")
+		_ = json.NewEncoder(w).Encode(s.keys.Load())
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) setKeys(keys ...JWK) {
+	s.keys.Store(&JWKS{Keys: keys})
+}
+
+// defaultJWKS Authing 签发方发布的公钥
+func defaultJWKS() []JWK {
+	return []JWK{
+		jwkFor("rsa-1", "RS256", testKeys.rsa.Public()),
+		jwkFor("ec-1", "ES256", testKeys.ec.Public()),
+		jwkFor("ed-1", "EdDSA", testKeys.ed.Public()),
+	}
+}
+
+// newTestAuth 信任 JWKS 签发方（Authing）和 HS256 签发方（学校 SSO）的中间件
+func newTestAuth(t *testing.T, jwksURL string) *AuthingMiddleware {
+	t.Helper()
+	a, err := NewAuthingMiddleware(
+		IssuerConfig{Issuer: testIssuer, JWKSURL: jwksURL, Audiences: []string{testAudience}},
+		IssuerConfig{Issuer: testSSOIssuer, ClientSecret: testSecret, SubjectPrefix: "edu:"},
+	)
+	if err != nil {
+		t.Fatalf("NewAuthingMiddleware: %v", err)
+	}
+	a.ClockSkew = 30 * time.Second
+	return a
+}
+
+// validClaims 能通过 Authing 签发方校验的声明
+func validClaims(sub string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss": testIssuer,
+		"sub": sub,
+		"aud": testAudience,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return s
+}
+
+func withClaims(base jwt.MapClaims, changes map[string]any) jwt.MapClaims {
+	claims := jwt.MapClaims{}
+	for k, v := range base {
+		claims[k] = v
+	}
+	for k, v := range changes {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	return claims
+}
+
+func TestNewAuthingMiddlewareRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		issuers []IssuerConfig
+		wantErr string
+	}{
+		{"no issuers", nil, "at least one trusted issuer"},
+		{"missing issuer with several", []IssuerConfig{
+			{JWKSURL: "https://a.example/jwks"},
+			{Issuer: "b", ClientSecret: "s"},
+		}, "issuer must be set"},
+		{"duplicate issuer", []IssuerConfig{
+			{Issuer: "a", ClientSecret: "s"},
+			{Issuer: "a", ClientSecret: "t"},
+		}, "duplicate issuer"},
+		{"no key material", []IssuerConfig{{Issuer: "a"}}, "jwks url or client secret is required"},
+		{"asymmetric alg without jwks", []IssuerConfig{
+			{Issuer: "a", ClientSecret: "s", Algorithms: []string{"RS256"}},
+		}, "requires a jwks url"},
+		{"hmac alg without secret", []IssuerConfig{
+			{Issuer: "a", JWKSURL: "https://a.example/jwks", Algorithms: []string{"HS256"}},
+		}, "requires a client secret"},
+		{"unsupported alg", []IssuerConfig{
+			{Issuer: "a", JWKSURL: "https://a.example/jwks", Algorithms: []string{"none"}},
+		}, "unsupported algorithm"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAuthingMiddleware(tt.issuers...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("NewAuthingMiddleware() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	a := newTestAuth(t, jwks.URL)
+	now := time.Now()
+
+	ssoClaims := jwt.MapClaims{
+		"iss": testSSOIssuer,
+		"sub": "alice",
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		wantUser string
+		wantErr  string
+	}{
+		{
+			name:     "RS256 from jwks",
+			token:    signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, validClaims("u1")),
+			wantUser: "u1",
+		},
+		{
+			name:     "ES256 from jwks",
+			token:    signToken(t, jwt.SigningMethodES256, "ec-1", testKeys.ec, validClaims("u2")),
+			wantUser: "u2",
+		},
+		{
+			name:     "EdDSA from jwks",
+			token:    signToken(t, jwt.SigningMethodEdDSA, "ed-1", testKeys.ed, validClaims("u3")),
+			wantUser: "u3",
+		},
+		{
+			name:     "no kid picks first key for alg",
+			token:    signToken(t, jwt.SigningMethodES256, "", testKeys.ec, validClaims("u4")),
+			wantUser: "u4",
+		},
+		{
+			name:     "HS256 from secret issuer gets subject prefix",
+			token:    signToken(t, jwt.SigningMethodHS256, "", []byte(testSecret), ssoClaims),
+			wantUser: "edu:alice",
+		},
+		{
+			name:     "array aud containing audience",
+			token:    signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u5"), map[string]any{"aud": []string{"other", testAudience}})),
+			wantUser: "u5",
+		},
+		{
+			name:     "expired within clock skew",
+			token:    signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u6"), map[string]any{"exp": now.Add(-10 * time.Second).Unix()})),
+			wantUser: "u6",
+		},
+		{
+			name:     "iat slightly in the future within clock skew",
+			token:    signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u7"), map[string]any{"iat": now.Add(10 * time.Second).Unix()})),
+			wantUser: "u7",
+		},
+		{
+			name:    "untrusted issuer",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"iss": "https://evil.example"})),
+			wantErr: "invalid issuer",
+		},
+		{
+			name:    "missing issuer with several trusted issuers",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"iss": nil})),
+			wantErr: "invalid issuer",
+		},
+		{
+			name:    "HS256 presented to jwks issuer",
+			token:   signToken(t, jwt.SigningMethodHS256, "", []byte(testSecret), validClaims("u1")),
+			wantErr: "invalid token",
+		},
+		{
+			name:    "RS256 presented to secret issuer",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, ssoClaims),
+			wantErr: "invalid token",
+		},
+		{
+			name:    "alg differs from alg declared in jwk",
+			token:   signToken(t, jwt.SigningMethodPS256, "rsa-1", testKeys.rsa, validClaims("u1")),
+			wantErr: "invalid token",
+		},
+		{
+			name:    "kid of a different key type",
+			token:   signToken(t, jwt.SigningMethodES256, "rsa-1", testKeys.ec, validClaims("u1")),
+			wantErr: "invalid token",
+		},
+		{
+			name:    "alg none",
+			token:   signToken(t, jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType, validClaims("u1")),
+			wantErr: "invalid token",
+		},
+		{
+			name:    "signed by unpublished key",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsaOnly, validClaims("u1")),
+			wantErr: "invalid token",
+		},
+		{
+			name:    "wrong HMAC secret",
+			token:   signToken(t, jwt.SigningMethodHS256, "", []byte("not-the-secret"), ssoClaims),
+			wantErr: "invalid token",
+		},
+		{
+			name:    "expired beyond clock skew",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"exp": now.Add(-2 * time.Minute).Unix()})),
+			wantErr: "token expired",
+		},
+		{
+			name:    "nbf beyond clock skew",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"nbf": now.Add(2 * time.Minute).Unix()})),
+			wantErr: "token not valid yet",
+		},
+		{
+			name:    "iat beyond clock skew",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"iat": now.Add(2 * time.Minute).Unix()})),
+			wantErr: "token not valid yet",
+		},
+		{
+			name:    "missing exp",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"exp": nil})),
+			wantErr: "token has no expiration",
+		},
+		{
+			name:    "back-channel logout token",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, logoutClaims(nil)),
+			wantErr: "logout token cannot be used",
+		},
+		{
+			name:    "sub-only logout token without exp",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, logoutClaims(map[string]any{"sid": nil, "exp": nil})),
+			wantErr: "token has no expiration",
+		},
+		{
+			name:    "logout token from secret issuer",
+			token:   signToken(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaims(ssoClaims, map[string]any{"events": map[string]any{backchannelLogoutEvent: map[string]any{}}})),
+			wantErr: "logout token cannot be used",
+		},
+		{
+			name:    "wrong audience",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"aud": "other"})),
+			wantErr: "invalid audience",
+		},
+		{
+			name:    "array aud without audience",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"aud": []string{"a", "b"}})),
+			wantErr: "invalid audience",
+		},
+		{
+			name:    "missing aud",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"aud": nil})),
+			wantErr: "invalid audience",
+		},
+		{
+			name:    "missing sub",
+			token:   signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"sub": nil})),
+			wantErr: "user ID not found",
+		},
+		{
+			name:    "malformed token",
+			token:   "not.a.jwt",
+			wantErr: "invalid token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := a.Authenticate(context.Background(), tt.token)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if got, _ := GetUserIDFromContext(ctx); got != tt.wantUser {
+				t.Errorf("user ID = %q, want %q", got, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestAuthenticateSingleIssuerWithoutIss(t *testing.T) {
+	a, err := NewAuthingMiddleware(IssuerConfig{ClientSecret: testSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := jwt.MapClaims{"sub": "u1", "iss": "anything", "exp": time.Now().Add(time.Hour).Unix()}
+	if _, err := a.Authenticate(context.Background(), signToken(t, jwt.SigningMethodHS256, "", []byte(testSecret), claims)); err != nil {
+		t.Fatalf("Authenticate() error = %v, want any iss accepted", err)
+	}
+}
+
+func TestAuthenticateTenantClaim(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	a := newTestAuth(t, jwks.URL)
+
+	token := signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{TenantClaim: "t1"}))
+	ctx, err := a.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := ctx.Value(tenantClaimKey).(string); got != "t1" {
+		t.Errorf("tenant claim = %q, want t1", got)
+	}
+}
+
+type fakeAPIKeys struct {
+	principal *APIKeyPrincipal
+	err       error
+	got       string
+}
+
+func (f *fakeAPIKeys) VerifyAPIKey(_ context.Context, key string) (*APIKeyPrincipal, error) {
+	f.got = key
+	return f.principal, f.err
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	const key = "dtk_0123456789ab_secret"
+
+	t.Run("disabled", func(t *testing.T) {
+		a := newTestAuth(t, jwks.URL)
+		if _, err := a.Authenticate(context.Background(), key); err == nil {
+			t.Fatal("Authenticate() accepted an api key without a verifier")
+		}
+	})
+
+	t.Run("verified", func(t *testing.T) {
+		a := newTestAuth(t, jwks.URL)
+		verifier := &fakeAPIKeys{principal: &APIKeyPrincipal{KeyID: 7, UserID: "u1", TenantID: "t1", Scopes: []string{ScopeRead}}}
+		a.APIKeys = verifier
+
+		ctx, err := a.Authenticate(context.Background(), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if verifier.got != key {
+			t.Errorf("verifier got %q, want %q", verifier.got, key)
+		}
+		if got, _ := GetUserIDFromContext(ctx); got != "u1" {
+			t.Errorf("user ID = %q, want u1", got)
+		}
+		if p, ok := GetAPIKeyFromContext(ctx); !ok || p.KeyID != 7 {
+			t.Errorf("api key principal = %+v, want key 7", p)
+		}
+		if got, _ := ctx.Value(tenantClaimKey).(string); got != "t1" {
+			t.Errorf("tenant = %q, want t1", got)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		a := newTestAuth(t, jwks.URL)
+		a.APIKeys = &fakeAPIKeys{err: errors.New("invalid api key")}
+		if _, err := a.Authenticate(context.Background(), key); err == nil {
+			t.Fatal("Authenticate() accepted a rejected api key")
+		}
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	valid := signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, validClaims("u1"))
+
+	tests := []struct {
+		name       string
+		optional   bool
+		header     string
+		wantStatus int
+		wantUser   string
+	}{
+		{"valid token", false, "Bearer " + valid, http.StatusOK, "u1"},
+		{"missing header", false, "", http.StatusUnauthorized, ""},
+		{"missing header optional", true, "", http.StatusOK, ""},
+		{"invalid token optional", true, "Bearer garbage", http.StatusUnauthorized, ""},
+		{"not bearer", false, "Basic dXNlcjpwYXNz", http.StatusUnauthorized, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestAuth(t, jwks.URL)
+			a.Optional = tt.optional
+
+			var gotUser string
+			h := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUser, _ = GetUserIDFromContext(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodPost, "/query", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if gotUser != tt.wantUser {
+				t.Errorf("user ID = %q, want %q", gotUser, tt.wantUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OpenID Connect Back-Channel Logout：用户在 Authing 登出时，Authing 向 BackchannelLogoutPath POST 一个
+// 签名的 logout_token，按其中的 sid 撤销对应会话，只有 sub 时撤销用户的全部会话
+// https://openid.net/specs/openid-connect-backchannel-1_0.html
+
+const (
+	BackchannelLogoutPath = "/auth/backchannel-logout"
+
+	backchannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	maxLogoutRequestBytes  = 64 << 10
+)
+
+// BackchannelLogoutHandler 挂载在 BackchannelLogoutPath，需要设置 Sessions
+func (a *AuthingMiddleware) BackchannelLogoutHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxLogoutRequestBytes)
+		if err := r.ParseForm(); err != nil {
+			writeLogoutError(w, "invalid_request", "malformed form body")
+			return
+		}
+
+		issuer, sid, userID, err := a.parseLogoutToken(r.PostForm.Get("logout_token"))
+		if err != nil {
+			Logger(r.Context()).Info("rejected back-channel logout", "error", err)
+			writeLogoutError(w, "invalid_request", err.Error())
+			return
+		}
+
+		if sid != "" {
+			err = a.Sessions.LogoutSession(r.Context(), issuer, sid, userID)
+		} else {
+			err = a.Sessions.LogoutUser(r.Context(), userID)
+		}
+		if err != nil {
+			Logger(r.Context()).Error("failed to process back-channel logout", "user_id", userID, "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		Logger(r.Context()).Info("back-channel logout", "issuer", issuer, "user_id", userID, "has_sid", sid != "")
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// parseLogoutToken 校验 logout_token，返回签发方、sid 和用户 ID（sid 和用户 ID 至少有一个）
+func (a *AuthingMiddleware) parseLogoutToken(tokenStr string) (issuer, sid, userID string, err error) {
+	if tokenStr == "" {
+		return "", "", "", errors.New("missing logout_token")
+	}
+	claims, iss, err := a.parse(tokenStr)
+	if err != nil {
+		return "", "", "", errors.New("invalid logout_token")
+	}
+	if iat, _ := claims.GetIssuedAt(); iat == nil {
+		return "", "", "", errors.New("logout_token must contain iat")
+	}
+	if !isLogoutToken(claims) {
+		return "", "", "", errors.New("logout_token is missing the back-channel logout event")
+	}
+	// 防止把 ID token 当作 logout_token 使用
+	if _, ok := claims["nonce"]; ok {
+		return "", "", "", errors.New("logout_token must not contain nonce")
+	}
+
+	issuer, _ = claims.GetIssuer()
+	sid, _ = claims["sid"].(string)
+	if sub, _ := claims["sub"].(string); sub != "" {
+		userID = iss.SubjectPrefix + sub
+	}
+	if sid == "" && userID == "" {
+		return "", "", "", errors.New("logout_token must contain sub or sid")
+	}
+	return issuer, sid, userID, nil
+}
+
+// isLogoutToken 判断 token 是否声明了 back-channel logout 事件
+func isLogoutToken(claims jwt.MapClaims) bool {
+	events, _ := claims["events"].(map[string]any)
+	_, ok := events[backchannelLogoutEvent].(map[string]any)
+	return ok
+}
+
+func writeLogoutError(w http.ResponseWriter, code, description string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code, "error_description": description})
+}
@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func logoutClaims(changes map[string]any) jwt.MapClaims {
+	now := time.Now()
+	return withClaims(jwt.MapClaims{
+		"iss":    testIssuer,
+		"aud":    testAudience,
+		"iat":    now.Unix(),
+		"exp":    now.Add(2 * time.Minute).Unix(),
+		"jti":    "logout-1",
+		"sub":    "u1",
+		"sid":    "s1",
+		"events": map[string]any{backchannelLogoutEvent: map[string]any{}},
+	}, changes)
+}
+
+func TestBackchannelLogoutHandler(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	sign := func(claims jwt.MapClaims) string {
+		return signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, claims)
+	}
+	ssoToken := signToken(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaims(logoutClaims(nil), map[string]any{
+		"iss": testSSOIssuer,
+		"aud": nil,
+		"sid": nil,
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		token       string
+		registryErr error
+		wantStatus  int
+		wantSid     []string // issuer|sid|user
+		wantUsers   []string
+	}{
+		{
+			name:       "sid and sub",
+			token:      sign(logoutClaims(nil)),
+			wantStatus: http.StatusOK,
+			wantSid:    []string{testIssuer + "|s1|u1"},
+		},
+		{
+			name:       "sid only",
+			token:      sign(logoutClaims(map[string]any{"sub": nil})),
+			wantStatus: http.StatusOK,
+			wantSid:    []string{testIssuer + "|s1|"},
+		},
+		{
+			name:       "sub only logs out every session with subject prefix",
+			token:      ssoToken,
+			wantStatus: http.StatusOK,
+			wantUsers:  []string{"edu:u1"},
+		},
+		{
+			name:       "no exp",
+			token:      sign(logoutClaims(map[string]any{"exp": nil})),
+			wantStatus: http.StatusOK,
+			wantSid:    []string{testIssuer + "|s1|u1"},
+		},
+		{
+			name:       "wrong method",
+			method:     http.MethodGet,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "missing token",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "untrusted issuer",
+			token:      sign(logoutClaims(map[string]any{"iss": "https://evil.example"})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "wrong audience",
+			token:      sign(logoutClaims(map[string]any{"aud": "other"})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "signed by unpublished key",
+			token:      signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsaOnly, logoutClaims(nil)),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "expired",
+			token:      sign(logoutClaims(map[string]any{"exp": time.Now().Add(-time.Hour).Unix()})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing iat",
+			token:      sign(logoutClaims(map[string]any{"iat": nil})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing logout event",
+			token:      sign(logoutClaims(map[string]any{"events": nil})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "other event",
+			token:      sign(logoutClaims(map[string]any{"events": map[string]any{"urn:example:other": map[string]any{}}})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "id token with nonce",
+			token:      sign(logoutClaims(map[string]any{"nonce": "n"})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "neither sub nor sid",
+			token:      sign(logoutClaims(map[string]any{"sub": nil, "sid": nil})),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "registry failure",
+			token:       sign(logoutClaims(nil)),
+			registryErr: errors.New("db down"),
+			wantStatus:  http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions := &fakeSessions{err: tt.registryErr}
+			a := newTestAuth(t, jwks.URL)
+			a.Sessions = sessions
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			form := url.Values{}
+			if tt.token != "" {
+				form.Set("logout_token", tt.token)
+			}
+			req := httptest.NewRequest(method, BackchannelLogoutPath, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rec := httptest.NewRecorder()
+			a.BackchannelLogoutHandler().ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %q)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+				t.Errorf("Cache-Control = %q, want no-store", got)
+			}
+			if rec.Code == http.StatusBadRequest {
+				var body map[string]string
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body["error"] != "invalid_request" {
+					t.Errorf("error body = %q, want invalid_request", rec.Body.String())
+				}
+			}
+			if !slices.Equal(sessions.logoutSid, tt.wantSid) {
+				t.Errorf("LogoutSession calls = %q, want %q", sessions.logoutSid, tt.wantSid)
+			}
+			if !slices.Equal(sessions.logoutUsers, tt.wantUsers) {
+				t.Errorf("LogoutUser calls = %q, want %q", sessions.logoutUsers, tt.wantUsers)
+			}
+		})
+	}
+}
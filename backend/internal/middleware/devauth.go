@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 本地开发和集成测试用的认证：用本地生成的 Ed25519 密钥签发 token，并在 DevJWKSPath 提供 JWKS，
+// 不需要访问 Authing。密钥保存在文件中，服务和 datai dev-token 命令共用
+
+const (
+	DevJWKSPath  = "/.well-known/jwks.json"
+	DevAlgorithm = "EdDSA"
+)
+
+type DevAuth struct {
+	Issuer string
+
+	key ed25519.PrivateKey
+	kid string
+}
+
+// LoadDevAuth 读取 keyFile 中的私钥，文件不存在时生成新的密钥并写入
+func LoadDevAuth(keyFile, issuer string) (*DevAuth, error) {
+	if issuer == "" {
+		return nil, errors.New("dev auth issuer is required")
+	}
+
+	key, err := readDevKey(keyFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		key, err = generateDevKey(keyFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pub := key.Public().(ed25519.PublicKey)
+	sum := sha256.Sum256(pub)
+	return &DevAuth{
+		Issuer: issuer,
+		key:    key,
+		kid:    "dev-" + base64.RawURLEncoding.EncodeToString(sum[:8]),
+	}, nil
+}
+
+func readDevKey(keyFile string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("dev auth key %s: no PEM data", keyFile)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("dev auth key %s: %w", keyFile, err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("dev auth key %s: not an Ed25519 private key", keyFile)
+	}
+	return key, nil
+}
+
+func generateDevKey(keyFile string) (ed25519.PrivateKey, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if dir := filepath.Dir(keyFile); dir != "." {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("create dev auth key dir: %w", err)
+		}
+	}
+	// O_EXCL：服务和 dev-token 命令同时启动时只有一方写入，另一方重新读取
+	f, err := os.OpenFile(keyFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, fs.ErrExist) {
+		return readDevKey(keyFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("write dev auth key: %w", err)
+	}
+	defer f.Close()
+	if err := pem.Encode(f, &pem.Block{Type: "PRIVATE KEY", Bytes: der}); err != nil {
+		return nil, fmt.Errorf("write dev auth key: %w", err)
+	}
+	return key, nil
+}
+
+// JWKS 只包含开发密钥的公钥
+func (d *DevAuth) JWKS() JWKS {
+	return JWKS{Keys: []JWK{{
+		Kid: d.kid,
+		Kty: "OKP",
+		Crv: "Ed25519",
+		Alg: DevAlgorithm,
+		Use: "sig",
+		X:   base64.RawURLEncoding.EncodeToString(d.key.Public().(ed25519.PublicKey)),
+	}}}
+}
+
+// JWKSHandler 挂载在 DevJWKSPath
+func (d *DevAuth) JWKSHandler() http.Handler {
+	body, _ := json.Marshal(d.JWKS())
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "max-age=300")
+		_, _ = w.Write(body)
+	})
+}
+
+// IssuerConfig 信任开发签发方的配置，jwksURL 指向本服务的 DevJWKSPath
+func (d *DevAuth) IssuerConfig(jwksURL string) IssuerConfig {
+	return IssuerConfig{
+		Issuer:     d.Issuer,
+		JWKSURL:    jwksURL,
+		Algorithms: []string{DevAlgorithm},
+	}
+}
+
+// Mint 签发 sub 的 token；tenantID 不为空时写入租户声明
+func (d *DevAuth) Mint(sub, tenantID string, ttl time.Duration) (string, error) {
+	if sub == "" {
+		return "", errors.New("sub is required")
+	}
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss": d.Issuer,
+		"sub": sub,
+		"iat": now.Unix(),
+		"exp": now.Add(ttl).Unix(),
+	}
+	if tenantID != "" {
+		claims[TenantClaim] = tenantID
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = d.kid
+	return token.SignedString(d.key)
+}
@@ -0,0 +1,410 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// 从签发方的 JWKS 地址获取公钥，支持 RSA、EC（P-256/P-384/P-521）和 OKP（Ed25519）
+
+// JWKS 结构
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+type JWK struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC、OKP
+	X string `json:"x"`
+	Y string `json:"y"`
+}
+
+// PublicKey 把 JWK 转换为 jwt 库校验签名所需的公钥类型
+func (k JWK) PublicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		return jwkToRSAPublicKey(k)
+	case "EC":
+		return jwkToECDSAPublicKey(k)
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported OKP curve: %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", k.Kty)
+	}
+}
+
+// publicKey JWKS 中可用于校验签名的公钥
+type publicKey struct {
+	kid string
+	alg string // JWK 中声明的算法，可以为空
+	key any
+}
+
+const (
+	defaultKeysTTL = 1 * time.Hour   // 响应没有缓存头时的有效期
+	minKeysTTL     = 1 * time.Minute // 缓存头给出的有效期在此范围内截断
+	maxKeysTTL     = 24 * time.Hour
+	minRefetch     = 1 * time.Minute // 遇到未知 kid 时重新获取 JWKS 的最小间隔
+	maxRetryDelay  = 5 * time.Minute // 后台刷新失败后重试的最大间隔
+)
+
+// jwksMetrics 通过 expvar 在管理端口的 /debug/vars 导出，按 JWKS 地址分组
+var jwksMetrics = expvar.NewMap("jwks")
+
+type keySetMetrics struct {
+	hits        expvar.Int // 缓存命中
+	misses      expvar.Int // 缓存中找不到 kid
+	fetches     expvar.Int // 成功获取（含 304）
+	notModified expvar.Int
+	fetchErrors expvar.Int
+	rateLimited expvar.Int // 未知 kid 因限流没有重新获取
+	keys        expvar.Int // 当前缓存的公钥数量
+	lastRefresh expvar.Int // 最近一次成功获取的 unix 时间
+}
+
+// keySet 缓存一个 JWKS 地址的公钥：
+// 后台按缓存头（Cache-Control max-age、Expires）在过期前刷新；遇到未知 kid 时限流地立即重新获取；
+// 获取失败时继续使用缓存中的公钥
+type keySet struct {
+	url     string
+	client  *http.Client
+	group   singleflight.Group
+	metrics keySetMetrics
+
+	mu          sync.RWMutex
+	keys        []publicKey
+	etag        string
+	fetchedAt   time.Time // 最近一次成功获取，零值表示还没有获取过
+	expiresAt   time.Time
+	lastAttempt time.Time // 最近一次发起获取，用于限流
+}
+
+func newKeySet(url string) *keySet {
+	s := &keySet{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	m := new(expvar.Map).Init()
+	m.Set("hits", &s.metrics.hits)
+	m.Set("misses", &s.metrics.misses)
+	m.Set("fetches", &s.metrics.fetches)
+	m.Set("not_modified", &s.metrics.notModified)
+	m.Set("fetch_errors", &s.metrics.fetchErrors)
+	m.Set("rate_limited", &s.metrics.rateLimited)
+	m.Set("keys", &s.metrics.keys)
+	m.Set("last_refresh", &s.metrics.lastRefresh)
+	jwksMetrics.Set(url, m)
+	return s
+}
+
+// get 按 kid 查找能校验 alg 签名的公钥；token 没有 kid 时取 JWKS 中第一个匹配算法的公钥
+func (s *keySet) get(kid, alg string) (any, error) {
+	s.mu.RLock()
+	key, ok := s.find(kid, alg)
+	loaded := !s.fetchedAt.IsZero()
+	expired := time.Now().After(s.expiresAt)
+	s.mu.RUnlock()
+
+	if ok {
+		s.metrics.hits.Add(1)
+		// 没有启动后台刷新时，过期后在后台刷新，本次先使用旧的公钥
+		if expired && s.allowFetch() {
+			go s.refresh()
+		}
+		return key, nil
+	}
+
+	s.metrics.misses.Add(1)
+	// 未知 kid 通常是签发方轮换了密钥，立即重新获取，但限制频率，避免伪造的 kid 打满签发方
+	if loaded && !s.allowFetch() {
+		s.metrics.rateLimited.Add(1)
+		return nil, s.notFound(kid, alg)
+	}
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	key, ok = s.find(kid, alg)
+	s.mu.RUnlock()
+	if !ok {
+		return nil, s.notFound(kid, alg)
+	}
+	return key, nil
+}
+
+func (s *keySet) notFound(kid, alg string) error {
+	if kid == "" {
+		return fmt.Errorf("no %s signing keys available", alg)
+	}
+	return fmt.Errorf("key not found: %s", kid)
+}
+
+// find 调用方需持有读锁
+func (s *keySet) find(kid, alg string) (any, bool) {
+	for _, k := range s.keys {
+		if kid != "" && k.kid != kid {
+			continue
+		}
+		if (k.alg == "" || k.alg == alg) && keyMatchesAlg(k.key, alg) {
+			return k.key, true
+		}
+	}
+	return nil, false
+}
+
+// allowFetch 距上次发起获取超过 minRefetch 时返回 true，并占用这次机会
+func (s *keySet) allowFetch() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.lastAttempt) < minRefetch {
+		return false
+	}
+	s.lastAttempt = time.Now()
+	return true
+}
+
+// Run 在缓存过期前后台刷新，直到 ctx 被取消；失败时按指数退避重试
+func (s *keySet) Run(ctx context.Context) {
+	var retryDelay time.Duration
+	for {
+		wait := retryDelay
+		if wait == 0 {
+			s.mu.RLock()
+			if !s.fetchedAt.IsZero() {
+				// 在有效期剩余 10% 时刷新
+				wait = time.Until(s.expiresAt) - s.expiresAt.Sub(s.fetchedAt)/10
+			}
+			s.mu.RUnlock()
+		}
+
+		timer := time.NewTimer(max(wait, 0))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.mu.Lock()
+		s.lastAttempt = time.Now()
+		s.mu.Unlock()
+		if err := s.refresh(); err != nil {
+			retryDelay = min(max(2*retryDelay, 5*time.Second), maxRetryDelay)
+			slog.Warn("jwks refresh failed, serving cached keys", "url", s.url, "retry_in", retryDelay, "error", err)
+			continue
+		}
+		retryDelay = 0
+	}
+}
+
+// refresh 获取 JWKS，同一时间只发起一个请求，并发的调用共享结果
+func (s *keySet) refresh() error {
+	_, err, _ := s.group.Do("refresh", func() (any, error) {
+		err := s.fetch()
+		if err != nil {
+			s.metrics.fetchErrors.Add(1)
+		}
+		return nil, err
+	})
+	return err
+}
+
+func (s *keySet) fetch() error {
+	req, err := http.NewRequest(http.MethodGet, s.url, nil)
+	if err != nil {
+		return err
+	}
+	s.mu.RLock()
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+	s.mu.RUnlock()
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	now := time.Now()
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		s.mu.Lock()
+		s.fetchedAt = now
+		s.expiresAt = now.Add(keysTTL(resp.Header, now))
+		s.mu.Unlock()
+		s.metrics.fetches.Add(1)
+		s.metrics.notModified.Add(1)
+		s.metrics.lastRefresh.Set(now.Unix())
+		return nil
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	var jwks JWKS
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&jwks); err != nil {
+		return fmt.Errorf("decode jwks: %w", err)
+	}
+
+	keys := make([]publicKey, 0, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.PublicKey()
+		if err != nil {
+			continue // 跳过无效或不支持的key
+		}
+		keys = append(keys, publicKey{kid: k.Kid, alg: k.Alg, key: key})
+	}
+	if len(keys) == 0 {
+		// 不用空的 JWKS 覆盖可用的公钥
+		return errors.New("fetch jwks: no usable signing keys")
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.etag = resp.Header.Get("ETag")
+	s.fetchedAt = now
+	s.expiresAt = now.Add(keysTTL(resp.Header, now))
+	s.mu.Unlock()
+	s.metrics.fetches.Add(1)
+	s.metrics.keys.Set(int64(len(keys)))
+	s.metrics.lastRefresh.Set(now.Unix())
+	return nil
+}
+
+// keysTTL 按 Cache-Control max-age（优先）或 Expires 计算有效期，截断到 [minKeysTTL, maxKeysTTL]
+func keysTTL(h http.Header, now time.Time) time.Duration {
+	ttl := defaultKeysTTL
+	if expires, err := http.ParseTime(h.Get("Expires")); err == nil {
+		ttl = expires.Sub(now)
+	}
+	for _, directive := range strings.Split(h.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-cache", "no-store":
+			return minKeysTTL
+		case "max-age":
+			if secs, err := strconv.Atoi(value); err == nil {
+				ttl = time.Duration(secs) * time.Second
+			}
+		}
+	}
+	return min(max(ttl, minKeysTTL), maxKeysTTL)
+}
+
+// keyMatchesAlg 公钥类型（以及 EC 曲线）与签名算法是否对应
+func keyMatchesAlg(key any, alg string) bool {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		switch alg {
+		case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512":
+			return true
+		}
+	case *ecdsa.PublicKey:
+		switch alg {
+		case "ES256":
+			return k.Curve == elliptic.P256()
+		case "ES384":
+			return k.Curve == elliptic.P384()
+		case "ES512":
+			return k.Curve == elliptic.P521()
+		}
+	case ed25519.PublicKey:
+		return alg == "EdDSA"
+	}
+	return false
+}
+
+func jwkToRSAPublicKey(jwk JWK) (*rsa.PublicKey, error) {
+	// 解码N和E
+	nBytes, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode N: %w", err)
+	}
+
+	eBytes, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode E: %w", err)
+	}
+
+	// 转换为big.Int
+	n := new(big.Int).SetBytes(nBytes)
+	e := new(big.Int).SetBytes(eBytes)
+
+	// 创建RSA公钥
+	publicKey := &rsa.PublicKey{
+		N: n,
+		E: int(e.Int64()),
+	}
+
+	return publicKey, nil
+}
+
+func jwkToECDSAPublicKey(jwk JWK) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported EC curve: %s", jwk.Crv)
+	}
+
+	xBytes, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode X: %w", err)
+	}
+	yBytes, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode Y: %w", err)
+	}
+
+	publicKey := &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(xBytes),
+		Y:     new(big.Int).SetBytes(yBytes),
+	}
+	if !curve.IsOnCurve(publicKey.X, publicKey.Y) {
+		return nil, errors.New("EC point is not on curve")
+	}
+	return publicKey, nil
+}
@@ -0,0 +1,264 @@
+package middleware
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestJWKPublicKey(t *testing.T) {
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offCurve := jwkFor("ec", "ES256", testKeys.ec.Public())
+	offCurve.Y = offCurve.X
+
+	tests := []struct {
+		name    string
+		jwk     JWK
+		alg     string // 公钥应当能校验的算法
+		wantErr bool
+	}{
+		{name: "rsa", jwk: jwkFor("rsa", "RS256", testKeys.rsa.Public()), alg: "RS256"},
+		{name: "ec p-256", jwk: jwkFor("ec", "ES256", testKeys.ec.Public()), alg: "ES256"},
+		{name: "ec p-384", jwk: jwkFor("ec", "ES384", p384.Public()), alg: "ES384"},
+		{name: "ed25519", jwk: jwkFor("ed", "EdDSA", testKeys.ed.Public()), alg: "EdDSA"},
+		{name: "ec point off curve", jwk: offCurve, wantErr: true},
+		{name: "unsupported ec curve", jwk: JWK{Kty: "EC", Crv: "secp256k1", X: "AA", Y: "AA"}, wantErr: true},
+		{name: "unsupported okp curve", jwk: JWK{Kty: "OKP", Crv: "X25519", X: "AA"}, wantErr: true},
+		{name: "short ed25519 key", jwk: JWK{Kty: "OKP", Crv: "Ed25519", X: "AAAA"}, wantErr: true},
+		{name: "bad base64", jwk: JWK{Kty: "RSA", N: "!!", E: "AQAB"}, wantErr: true},
+		{name: "unsupported kty", jwk: JWK{Kty: "oct"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := tt.jwk.PublicKey()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("PublicKey() = %T, want error", key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PublicKey() error = %v", err)
+			}
+			if !keyMatchesAlg(key, tt.alg) {
+				t.Errorf("key %T does not match %s", key, tt.alg)
+			}
+		})
+	}
+}
+
+func TestKeyMatchesAlg(t *testing.T) {
+	tests := []struct {
+		name string
+		key  any
+		alg  string
+		want bool
+	}{
+		{"rsa RS256", testKeys.rsa.Public(), "RS256", true},
+		{"rsa PS512", testKeys.rsa.Public(), "PS512", true},
+		{"rsa ES256", testKeys.rsa.Public(), "ES256", false},
+		{"rsa HS256", testKeys.rsa.Public(), "HS256", false},
+		{"p-256 ES256", testKeys.ec.Public(), "ES256", true},
+		{"p-256 ES384", testKeys.ec.Public(), "ES384", false},
+		{"ed25519 EdDSA", testKeys.ed.Public(), "EdDSA", true},
+		{"ed25519 ES256", testKeys.ed.Public(), "ES256", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keyMatchesAlg(tt.key, tt.alg); got != tt.want {
+				t.Errorf("keyMatchesAlg(%T, %s) = %v, want %v", tt.key, tt.alg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeysTTL(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+	}{
+		{"no cache headers", http.Header{}, defaultKeysTTL},
+		{"max-age", http.Header{"Cache-Control": {"public, max-age=600"}}, 10 * time.Minute},
+		{"max-age wins over expires", http.Header{
+			"Cache-Control": {"max-age=600"},
+			"Expires":       {now.Add(2 * time.Hour).UTC().Format(http.TimeFormat)},
+		}, 10 * time.Minute},
+		{"expires", http.Header{"Expires": {now.Add(2 * time.Hour).UTC().Format(http.TimeFormat)}}, 2 * time.Hour},
+		{"no-store", http.Header{"Cache-Control": {"no-store"}}, minKeysTTL},
+		{"clamped to min", http.Header{"Cache-Control": {"max-age=5"}}, minKeysTTL},
+		{"clamped to max", http.Header{"Cache-Control": {"max-age=31536000"}}, maxKeysTTL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keysTTL(tt.header, now)
+			// Expires 只精确到秒
+			if diff := got - tt.want; diff < -time.Second || diff > time.Second {
+				t.Errorf("keysTTL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeySetGet(t *testing.T) {
+	tests := []struct {
+		name    string
+		kid     string
+		alg     string
+		wantKey any
+		wantErr bool
+	}{
+		{name: "rsa by kid", kid: "rsa-1", alg: "RS256", wantKey: testKeys.rsa.Public()},
+		{name: "ec by kid", kid: "ec-1", alg: "ES256", wantKey: testKeys.ec.Public()},
+		{name: "ed25519 by kid", kid: "ed-1", alg: "EdDSA", wantKey: testKeys.ed.Public()},
+		{name: "no kid", alg: "ES256", wantKey: testKeys.ec.Public()},
+		{name: "unknown kid", kid: "missing", alg: "RS256", wantErr: true},
+		{name: "alg not declared for kid", kid: "rsa-1", alg: "RS512", wantErr: true},
+		{name: "alg of another key type", kid: "ed-1", alg: "ES256", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newJWKSServer(t, defaultJWKS()...)
+			key, err := newKeySet(srv.URL).get(tt.kid, tt.alg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("get() = %T, want error", key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("get() error = %v", err)
+			}
+			if !publicKeyEqual(key, tt.wantKey) {
+				t.Errorf("get() returned a different key")
+			}
+		})
+	}
+}
+
+func TestKeySetUnknownKidRefetch(t *testing.T) {
+	srv := newJWKSServer(t, jwkFor("rsa-1", "RS256", testKeys.rsa.Public()))
+	ks := newKeySet(srv.URL)
+
+	if _, err := ks.get("rsa-1", "RS256"); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.requests.Load(); got != 1 {
+		t.Fatalf("requests after first get = %d, want 1", got)
+	}
+
+	// 缓存命中不请求签发方
+	if _, err := ks.get("rsa-1", "RS256"); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.requests.Load(); got != 1 {
+		t.Fatalf("requests after cache hit = %d, want 1", got)
+	}
+
+	// 签发方轮换密钥：未知 kid 立即重新获取
+	srv.setKeys(jwkFor("rsa-2", "RS256", testKeys.rsaOnly.Public()))
+	key, err := ks.get("rsa-2", "RS256")
+	if err != nil {
+		t.Fatalf("get() after rotation error = %v", err)
+	}
+	if !publicKeyEqual(key, testKeys.rsaOnly.Public()) {
+		t.Error("get() after rotation returned a different key")
+	}
+	if got := srv.requests.Load(); got != 2 {
+		t.Fatalf("requests after rotation = %d, want 2", got)
+	}
+
+	// minRefetch 内的未知 kid 不再请求签发方
+	if _, err := ks.get("forged", "RS256"); err == nil {
+		t.Fatal("get() accepted an unknown kid")
+	}
+	if got := srv.requests.Load(); got != 2 {
+		t.Fatalf("requests after rate-limited miss = %d, want 2", got)
+	}
+	if got := ks.metrics.rateLimited.Value(); got != 1 {
+		t.Errorf("rate_limited = %d, want 1", got)
+	}
+}
+
+func TestKeySetKeepsKeysWhenFetchFails(t *testing.T) {
+	tests := []struct {
+		name        string
+		breakServer func(*jwksServer)
+	}{
+		{"server error", func(s *jwksServer) { s.status.Store(http.StatusInternalServerError) }},
+		{"no usable keys", func(s *jwksServer) { s.setKeys(JWK{Kid: "enc", Kty: "RSA", Use: "enc"}) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newJWKSServer(t, defaultJWKS()...)
+			ks := newKeySet(srv.URL)
+			if _, err := ks.get("rsa-1", "RS256"); err != nil {
+				t.Fatal(err)
+			}
+
+			tt.breakServer(srv)
+			if _, err := ks.get("unknown", "RS256"); err == nil {
+				t.Fatal("get() accepted an unknown kid")
+			}
+			if got := ks.metrics.fetchErrors.Value(); got != 1 {
+				t.Errorf("fetch_errors = %d, want 1", got)
+			}
+			if _, err := ks.get("rsa-1", "RS256"); err != nil {
+				t.Fatalf("cached key lost after failed fetch: %v", err)
+			}
+		})
+	}
+}
+
+func TestKeySetNotModified(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"keys":[{"kid":"ed-1","kty":"OKP","crv":"Ed25519","x":"` + jwkFor("", "", testKeys.ed.Public()).X + `"}]}`))
+	}))
+	defer srv.Close()
+
+	ks := newKeySet(srv.URL)
+	if err := ks.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.refresh(); err != nil {
+		t.Fatalf("refresh() with 304 error = %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("requests = %d, want 2", requests)
+	}
+	if got := ks.metrics.notModified.Value(); got != 1 {
+		t.Errorf("not_modified = %d, want 1", got)
+	}
+	if _, err := ks.get("ed-1", "EdDSA"); err != nil {
+		t.Fatalf("key lost after 304: %v", err)
+	}
+}
+
+func publicKeyEqual(a, b any) bool {
+	switch k := a.(type) {
+	case *rsa.PublicKey:
+		return k.Equal(b)
+	case *ecdsa.PublicKey:
+		return k.Equal(b)
+	case ed25519.PublicKey:
+		return k.Equal(b)
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// 请求日志：每个请求结束时输出一条结构化日志，包含请求 ID、用户、GraphQL 操作名、状态码和耗时
+// 不记录任何请求头和请求体，token、密码等凭据不会出现在日志中
+
+const RequestIDHeader = "X-Request-ID"
+
+const requestInfoKey contextKey = "request_info"
+
+// 客户端传入的请求 ID 只接受常见字符，避免日志注入
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// requestInfo 在请求处理过程中由内层中间件补充，请求结束时写入日志
+type requestInfo struct {
+	id string
+
+	mu        sync.Mutex
+	userID    string
+	operation string
+}
+
+// RequestIDFromContext 当前请求的 ID，不在请求中时返回空字符串
+func RequestIDFromContext(ctx context.Context) string {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		return info.id
+	}
+	return ""
+}
+
+// Logger 带有当前请求 ID 的 logger
+func Logger(ctx context.Context) *slog.Logger {
+	if id := RequestIDFromContext(ctx); id != "" {
+		return slog.Default().With("request_id", id)
+	}
+	return slog.Default()
+}
+
+func setLogUser(ctx context.Context, userID string) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.mu.Lock()
+		info.userID = userID
+		info.mu.Unlock()
+	}
+}
+
+// LogOperation gqlgen 的 AroundOperations 钩子，记录 GraphQL 操作名
+func LogOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		oc := graphql.GetOperationContext(ctx)
+		name := oc.OperationName
+		if name == "" && oc.Operation != nil {
+			name = oc.Operation.Name
+		}
+		if name != "" {
+			info.mu.Lock()
+			// 同一个 WebSocket 连接上可能有多个订阅
+			if info.operation == "" {
+				info.operation = name
+			} else if !strings.Contains(","+info.operation+",", ","+name+",") {
+				info.operation += "," + name
+			}
+			info.mu.Unlock()
+		}
+	}
+	return next(ctx)
+}
+
+// Logging 最外层的请求日志中间件；quiet 中的路径（如健康检查）以 debug 级别记录
+func Logging(logger *slog.Logger, quiet ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			info := &requestInfo{id: requestID(r)}
+			w.Header().Set(RequestIDHeader, info.id)
+
+			rw := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), requestInfoKey, info)))
+
+			status := rw.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			level := slog.LevelInfo
+			switch {
+			case status >= 500:
+				level = slog.LevelError
+			case status >= 400:
+				level = slog.LevelWarn
+			case slices.Contains(quiet, r.URL.Path):
+				level = slog.LevelDebug
+			}
+
+			info.mu.Lock()
+			attrs := []slog.Attr{
+				slog.String("request_id", info.id),
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", status),
+				slog.Duration("latency", time.Since(start)),
+				slog.Int("bytes", rw.bytes),
+				slog.String("remote_addr", r.RemoteAddr),
+			}
+			if info.userID != "" {
+				attrs = append(attrs, slog.String("user_id", info.userID))
+			}
+			if info.operation != "" {
+				attrs = append(attrs, slog.String("operation", info.operation))
+			}
+			info.mu.Unlock()
+			if rw.hijacked {
+				attrs = append(attrs, slog.Bool("websocket", true))
+			}
+			logger.LogAttrs(r.Context(), level, "request", attrs...)
+		})
+	}
+}
+
+func requestID(r *http.Request) string {
+	if id := r.Header.Get(RequestIDHeader); requestIDPattern.MatchString(id) {
+		return id
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// statusRecorder 记录状态码和响应大小；实现 Hijacker 供 WebSocket 升级使用
+type statusRecorder struct {
+	http.ResponseWriter
+	status   int
+	bytes    int
+	hijacked bool
+}
+
+func (w *statusRecorder) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusRecorder) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+func (w *statusRecorder) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	conn, brw, err := h.Hijack()
+	if err == nil {
+		w.hijacked = true
+		w.status = http.StatusSwitchingProtocols
+	}
+	return conn, brw, err
+}
+
+// Unwrap 供 http.ResponseController 访问底层连接
+func (w *statusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// sensitiveKeys 日志属性名包含这些词时输出 REDACTED
+var sensitiveKeys = []string{"authorization", "token", "password", "secret", "cookie"}
+
+// RedactAttr 作为 slog.HandlerOptions.ReplaceAttr，兜底隐去误传入日志的凭据
+func RedactAttr(_ []string, a slog.Attr) slog.Attr {
+	key := strings.ToLower(a.Key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return slog.String(a.Key, "REDACTED")
+		}
+	}
+	return a
+}
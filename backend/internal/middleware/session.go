@@ -0,0 +1,187 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWT 在 exp 之前一直有效，登出或被盗的 token 需要通过会话登记撤销：
+// 中间件按 token 的 sid（没有时用 jti）查询会话是否已撤销，结果缓存 SessionCacheTTL，
+// 本进程内撤销会话时通过 ForgetSessions 立即清除缓存
+
+const (
+	sessionKey    contextKey = "session"
+	clientInfoKey contextKey = "client_info"
+
+	maxSessionCacheEntries = 10000
+	maxUserAgentLength     = 512
+)
+
+var ErrSessionRevoked = errors.New("session revoked")
+
+// Session 一个 JWT 登录会话
+type Session struct {
+	Issuer    string // token 的 iss
+	ID        string // token 的 sid，没有时为 jti；为空表示 token 无法单独撤销，只受"退出全部会话"影响
+	UserID    string
+	IssuedAt  time.Time // 没有 iat 时为零值
+	ExpiresAt time.Time // 没有 exp 时为零值
+	UserAgent string
+	IPAddress string
+}
+
+// SessionRegistry 登记和撤销会话，由用户服务实现
+type SessionRegistry interface {
+	// CheckSession 登记会话，返回它是否已被撤销
+	CheckSession(ctx context.Context, s *Session) (revoked bool, err error)
+	// LogoutSession 签发方通知某个会话已登出；userID 可能为空
+	LogoutSession(ctx context.Context, issuer, sessionID, userID string) error
+	// LogoutUser 签发方通知用户的全部会话已登出
+	LogoutUser(ctx context.Context, userID string) error
+}
+
+// GetSessionFromContext 请求使用 JWT 认证时返回对应的会话
+func GetSessionFromContext(ctx context.Context) (*Session, bool) {
+	s, ok := ctx.Value(sessionKey).(*Session)
+	return s, ok
+}
+
+// ForgetSessions 清除用户会话的撤销状态缓存，撤销会话后调用
+func (a *AuthingMiddleware) ForgetSessions(userID string) {
+	a.sessionCache.forget(userID)
+}
+
+// sessionFromClaims 从已验证的 token 中取出会话信息
+func sessionFromClaims(ctx context.Context, claims jwt.MapClaims, userID string) *Session {
+	s := &Session{UserID: userID}
+	s.Issuer, _ = claims.GetIssuer()
+	if sid, ok := claims["sid"].(string); ok && sid != "" {
+		s.ID = sid
+	} else if jti, ok := claims["jti"].(string); ok {
+		s.ID = jti
+	}
+	if iat, _ := claims.GetIssuedAt(); iat != nil {
+		s.IssuedAt = iat.Time
+	}
+	if exp, _ := claims.GetExpirationTime(); exp != nil {
+		s.ExpiresAt = exp.Time
+	}
+	if info, ok := ctx.Value(clientInfoKey).(clientInfo); ok {
+		s.UserAgent = info.userAgent
+		s.IPAddress = info.ip
+	}
+	return s
+}
+
+// checkSession 查询会话是否已撤销，优先使用缓存；查询失败时拒绝请求
+func (a *AuthingMiddleware) checkSession(ctx context.Context, s *Session) error {
+	key := s.cacheKey()
+	revoked, cached, gen := a.sessionCache.get(key)
+	if !cached {
+		var err error
+		revoked, err = a.Sessions.CheckSession(ctx, s)
+		if err != nil {
+			Logger(ctx).Error("failed to check session", "user_id", s.UserID, "error", err)
+			return errors.New("session check failed")
+		}
+		a.sessionCache.put(key, s.UserID, revoked, a.SessionCacheTTL, gen)
+	}
+	if revoked {
+		return ErrSessionRevoked
+	}
+	return nil
+}
+
+func (s *Session) cacheKey() string {
+	if s.ID != "" {
+		return s.Issuer + "\x00" + s.ID
+	}
+	// 无法单独撤销的 token 按签发时间区分
+	return s.Issuer + "\x00" + s.UserID + "\x00" + strconv.FormatInt(s.IssuedAt.Unix(), 10)
+}
+
+type sessionCacheEntry struct {
+	userID  string
+	revoked bool
+	expires time.Time
+}
+
+// sessionCache 会话撤销状态的进程内缓存，零值可用
+type sessionCache struct {
+	mu      sync.Mutex
+	entries map[string]sessionCacheEntry
+	gen     uint64 // 每次 forget 递增，避免把 forget 之前查到的结果写回缓存
+}
+
+func (c *sessionCache) get(key string) (revoked, ok bool, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return false, false, c.gen
+	}
+	return e.revoked, true, c.gen
+}
+
+func (c *sessionCache) put(key, userID string, revoked bool, ttl time.Duration, gen uint64) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]sessionCacheEntry)
+	}
+	now := time.Now()
+	if len(c.entries) >= maxSessionCacheEntries {
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxSessionCacheEntries {
+			clear(c.entries)
+		}
+	}
+	c.entries[key] = sessionCacheEntry{userID: userID, revoked: revoked, expires: now.Add(ttl)}
+}
+
+func (c *sessionCache) forget(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	for k, e := range c.entries {
+		if e.userID == userID {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// clientInfo 登记会话时记录的客户端信息
+type clientInfo struct {
+	userAgent string
+	ip        string
+}
+
+func withClientInfo(ctx context.Context, r *http.Request) context.Context {
+	ua := r.UserAgent()
+	if len(ua) > maxUserAgentLength {
+		ua = strings.ToValidUTF8(ua[:maxUserAgentLength], "")
+	}
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	return context.WithValue(ctx, clientInfoKey, clientInfo{userAgent: ua, ip: ip})
+}
@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// fakeSessions 内存中的 SessionRegistry，按 iss 和会话 ID 记录撤销状态
+type fakeSessions struct {
+	mu          sync.Mutex
+	revoked     map[string]bool // issuer + "\x00" + session ID
+	checked     []*Session
+	err         error
+	logoutSid   []string
+	logoutUsers []string
+}
+
+func (f *fakeSessions) CheckSession(_ context.Context, s *Session) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.checked = append(f.checked, s)
+	if f.err != nil {
+		return false, f.err
+	}
+	return f.revoked[s.Issuer+"\x00"+s.ID], nil
+}
+
+func (f *fakeSessions) LogoutSession(_ context.Context, issuer, sessionID, userID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.logoutSid = append(f.logoutSid, issuer+"|"+sessionID+"|"+userID)
+	return nil
+}
+
+func (f *fakeSessions) LogoutUser(_ context.Context, userID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.logoutUsers = append(f.logoutUsers, userID)
+	return nil
+}
+
+func (f *fakeSessions) revoke(issuer, sid string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.revoked == nil {
+		f.revoked = map[string]bool{}
+	}
+	f.revoked[issuer+"\x00"+sid] = true
+}
+
+func (f *fakeSessions) checks() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.checked)
+}
+
+func TestAuthenticateSession(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+
+	tests := []struct {
+		name    string
+		claims  map[string]any
+		revoked string // 被撤销的会话 ID
+		err     error
+		wantID  string
+		wantErr string
+	}{
+		{name: "active sid", claims: map[string]any{"sid": "s1"}, wantID: "s1"},
+		{name: "jti when no sid", claims: map[string]any{"jti": "j1"}, wantID: "j1"},
+		{name: "sid preferred over jti", claims: map[string]any{"sid": "s1", "jti": "j1"}, wantID: "s1"},
+		{name: "revoked sid", claims: map[string]any{"sid": "s1"}, revoked: "s1", wantErr: ErrSessionRevoked.Error()},
+		{name: "revoked jti", claims: map[string]any{"jti": "j1"}, revoked: "j1", wantErr: ErrSessionRevoked.Error()},
+		{name: "other session revoked", claims: map[string]any{"sid": "s2"}, revoked: "s1", wantID: "s2"},
+		{name: "registry failure", claims: map[string]any{"sid": "s1"}, err: errors.New("db down"), wantErr: "session check failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions := &fakeSessions{err: tt.err}
+			if tt.revoked != "" {
+				sessions.revoke(testIssuer, tt.revoked)
+			}
+			a := newTestAuth(t, jwks.URL)
+			a.Sessions = sessions
+
+			token := signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), tt.claims))
+			ctx, err := a.Authenticate(context.Background(), token)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			s, ok := GetSessionFromContext(ctx)
+			if !ok {
+				t.Fatal("session not in context")
+			}
+			if s.ID != tt.wantID || s.Issuer != testIssuer || s.UserID != "u1" {
+				t.Errorf("session = %+v, want ID %q for u1 from %s", s, tt.wantID, testIssuer)
+			}
+		})
+	}
+}
+
+func TestSessionCache(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	sessions := &fakeSessions{}
+	a := newTestAuth(t, jwks.URL)
+	a.Sessions = sessions
+	a.SessionCacheTTL = time.Minute
+
+	token := signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"sid": "s1"}))
+	authenticate := func() error {
+		_, err := a.Authenticate(context.Background(), token)
+		return err
+	}
+
+	if err := authenticate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticate(); err != nil {
+		t.Fatal(err)
+	}
+	if got := sessions.checks(); got != 1 {
+		t.Fatalf("registry checks = %d, want 1 (second request from cache)", got)
+	}
+
+	// 撤销后缓存仍然有效，直到 ForgetSessions 清除
+	sessions.revoke(testIssuer, "s1")
+	if err := authenticate(); err != nil {
+		t.Fatalf("cached session rejected before ForgetSessions: %v", err)
+	}
+	a.ForgetSessions("u1")
+	if err := authenticate(); !errors.Is(err, ErrSessionRevoked) {
+		t.Fatalf("Authenticate() after ForgetSessions error = %v, want %v", err, ErrSessionRevoked)
+	}
+	if got := sessions.checks(); got != 2 {
+		t.Fatalf("registry checks = %d, want 2", got)
+	}
+}
+
+func TestSessionCacheDisabled(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	sessions := &fakeSessions{}
+	a := newTestAuth(t, jwks.URL)
+	a.Sessions = sessions
+
+	token := signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"sid": "s1"}))
+	for range 2 {
+		if _, err := a.Authenticate(context.Background(), token); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sessions.revoke(testIssuer, "s1")
+	if _, err := a.Authenticate(context.Background(), token); !errors.Is(err, ErrSessionRevoked) {
+		t.Fatalf("Authenticate() error = %v, want %v", err, ErrSessionRevoked)
+	}
+	if got := sessions.checks(); got != 3 {
+		t.Fatalf("registry checks = %d, want 3", got)
+	}
+}
+
+func TestSessionCacheIgnoresStaleResult(t *testing.T) {
+	var c sessionCache
+	_, _, gen := c.get("k")
+	c.forget("u1")
+	c.put("k", "u1", false, time.Minute, gen)
+	if _, ok, _ := c.get("k"); ok {
+		t.Fatal("result looked up before forget was cached")
+	}
+}
+
+func TestSessionClientInfo(t *testing.T) {
+	jwks := newJWKSServer(t, defaultJWKS()...)
+	sessions := &fakeSessions{}
+	a := newTestAuth(t, jwks.URL)
+	a.Sessions = sessions
+
+	token := signToken(t, jwt.SigningMethodRS256, "rsa-1", testKeys.rsa, withClaims(validClaims("u1"), map[string]any{"sid": "s1"}))
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	req.RemoteAddr = "203.0.113.7:51234"
+	req.Header.Set("User-Agent", strings.Repeat("a", maxUserAgentLength+10))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	a.Middleware(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(sessions.checked) != 1 {
+		t.Fatalf("registry checks = %d, want 1", len(sessions.checked))
+	}
+	s := sessions.checked[0]
+	if s.IPAddress != "203.0.113.7" {
+		t.Errorf("IPAddress = %q, want 203.0.113.7", s.IPAddress)
+	}
+	if len(s.UserAgent) != maxUserAgentLength {
+		t.Errorf("len(UserAgent) = %d, want %d", len(s.UserAgent), maxUserAgentLength)
+	}
+}
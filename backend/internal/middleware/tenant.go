@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// 解析当前请求的活跃租户：优先取 X-Tenant-ID 头部，其次取 JWT 中的 tenant_id 声明
+// 通过 user_tenants 校验调用者确实是该租户成员
+// 把活跃租户和调用者所属的全部租户注入 context，供活动查询做租户过滤
+
+const (
+	TenantHeader = "X-Tenant-ID"
+	TenantClaim  = "tenant_id"
+)
+
+const (
+	tenantClaimKey  contextKey = "tenant_claim"
+	activeTenantKey contextKey = "active_tenant_id"
+	tenantIDsKey    contextKey = "member_tenant_ids"
+)
+
+// TenantLookup 查询用户所属的租户 ID 列表，由租户服务实现
+type TenantLookup interface {
+	TenantIDsForUser(ctx context.Context, uid string) ([]string, error)
+}
+
+type TenantMiddleware struct {
+	lookup TenantLookup
+}
+
+func NewTenantMiddleware(lookup TenantLookup) *TenantMiddleware {
+	return &TenantMiddleware{lookup: lookup}
+}
+
+// GetActiveTenantFromContext 返回本次请求选中的租户，未选择时 ok 为 false
+func GetActiveTenantFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(activeTenantKey).(string)
+	return id, ok && id != ""
+}
+
+// GetTenantIDsFromContext 返回调用者所属的全部租户；匿名请求或未加入任何租户时为空
+func GetTenantIDsFromContext(ctx context.Context) []string {
+	ids, _ := ctx.Value(tenantIDsKey).([]string)
+	return ids
+}
+
+var (
+	ErrTenantAuthRequired = errors.New("tenant requires authentication")
+	ErrNotTenantMember    = errors.New("not a member of tenant")
+)
+
+// Middleware 需要放在 AuthingMiddleware 之后
+func (t *TenantMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// WebSocket 连接的租户在 connection_init 中由 WebSocketInit 解析
+		if IsWebSocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, err := t.Resolve(r.Context(), r.Header.Get(TenantHeader))
+		switch {
+		case errors.Is(err, ErrTenantAuthRequired):
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		case errors.Is(err, ErrNotTenantMember):
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		case err != nil:
+			http.Error(w, "failed to resolve tenant", http.StatusInternalServerError)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Resolve 校验请求的租户并注入 context；requested 为空时取 token 中声明的租户
+func (t *TenantMiddleware) Resolve(ctx context.Context, requested string) (context.Context, error) {
+	requested = strings.TrimSpace(requested)
+	if requested == "" {
+		requested, _ = ctx.Value(tenantClaimKey).(string)
+	}
+
+	userID, err := GetUserIDFromContext(ctx)
+	if err != nil {
+		// 匿名请求只能看到公开活动，不能指定租户
+		if requested != "" {
+			return nil, ErrTenantAuthRequired
+		}
+		return ctx, nil
+	}
+
+	tenantIDs, err := t.lookup.TenantIDsForUser(ctx, userID)
+	if err != nil {
+		Logger(ctx).Error("failed to load tenants", "user_id", userID, "error", err)
+		return nil, err
+	}
+	if requested != "" && !slices.Contains(tenantIDs, requested) {
+		return nil, ErrNotTenantMember
+	}
+	// 绑定租户的 API key 只能访问该租户，看不到用户所属的其他租户
+	if key, ok := GetAPIKeyFromContext(ctx); ok && key.TenantID != "" {
+		if requested != key.TenantID {
+			return nil, ErrNotTenantMember
+		}
+		tenantIDs = []string{requested}
+	}
+
+	ctx = context.WithValue(ctx, tenantIDsKey, tenantIDs)
+	if requested != "" {
+		ctx = context.WithValue(ctx, activeTenantKey, requested)
+	}
+	return ctx, nil
+}
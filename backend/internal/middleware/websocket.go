@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// 浏览器无法给 WebSocket 握手加 Authorization 头部，graphql-ws 客户端把 token 放在 connection_init 的 payload 中：
+// {"Authorization": "Bearer <token>", "X-Tenant-ID": "<tenant>"}
+
+// IsWebSocketUpgrade 判断请求是否为 WebSocket 握手
+func IsWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+// WebSocketInit 返回 gqlgen 的 WebsocketInitFunc，在 connection_init 时完成认证和租户解析
+func WebSocketInit(auth *AuthingMiddleware, tenant *TenantMiddleware) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		token := strings.TrimSpace(strings.TrimPrefix(payload.Authorization(), "Bearer "))
+		if token == "" {
+			if !auth.Optional {
+				return nil, nil, errors.New("missing authorization in connection_init payload")
+			}
+		} else {
+			authCtx, err := auth.Authenticate(ctx, token)
+			if err != nil {
+				Logger(ctx).Debug("websocket authentication failed", "error", err)
+				return nil, nil, err
+			}
+			ctx = authCtx
+		}
+
+		ctx, err := tenant.Resolve(ctx, payload.GetString(TenantHeader))
+		if err != nil {
+			return nil, nil, err
+		}
+		return ctx, nil, nil
+	}
+}
@@ -0,0 +1,207 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// 封装 golang-migrate，按数据库执行迁移、回滚、查看版本和修复 dirty 状态
+// 迁移文件放在 migrations/<name>/，命名为 <序号>_<描述>.up.sql / .down.sql
+
+var (
+	ErrInvalidSteps = errors.New("steps must not be zero")
+	ErrInvalidName  = errors.New("migration name must contain only letters, digits and underscores")
+)
+
+// undefinedTable PostgreSQL 的 undefined_table 错误码
+const undefinedTable = "42P01"
+
+var (
+	fileNamePattern = regexp.MustCompile(`^(\d+)_.+\.(up|down)\.sql$`)
+	namePattern     = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Database 一个需要管理迁移的数据库
+type Database struct {
+	Name string // 迁移目录名，例如 user、events
+	DSN  string
+	Dir  string // 迁移文件目录，例如 migrations/user
+}
+
+// Status 数据库当前的迁移状态
+type Status struct {
+	Version uint // 已应用的版本，尚未应用任何迁移时为 0
+	Latest  uint // 迁移目录中最新的版本
+	Dirty   bool // 上次迁移中途失败，需要人工修复后 force
+}
+
+func open(db Database) (*migrate.Migrate, error) {
+	m, err := migrate.New("file://"+db.Dir, db.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("init migration for %s: %w", db.Name, err)
+	}
+	return m, nil
+}
+
+func run(db Database, fn func(m *migrate.Migrate) error) error {
+	m, err := open(db)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := fn(m); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migrate %s: %w", db.Name, err)
+	}
+	return nil
+}
+
+// Up 应用全部未执行的迁移
+func Up(db Database) error {
+	return run(db, func(m *migrate.Migrate) error { return m.Up() })
+}
+
+// Steps 正数向上应用 n 个迁移，负数回滚 -n 个
+func Steps(db Database, n int) error {
+	if n == 0 {
+		return ErrInvalidSteps
+	}
+	return run(db, func(m *migrate.Migrate) error { return m.Steps(n) })
+}
+
+// Down 回滚最近的 n 个迁移
+func Down(db Database, n int) error {
+	if n <= 0 {
+		return ErrInvalidSteps
+	}
+	return Steps(db, -n)
+}
+
+// Force 把版本记录设为 version 并清除 dirty 标记，不执行任何迁移；version 为 -1 表示未应用任何迁移
+func Force(db Database, version int) error {
+	return run(db, func(m *migrate.Migrate) error { return m.Force(version) })
+}
+
+// GetStatus 读取数据库当前版本和迁移目录中的最新版本
+func GetStatus(db Database) (*Status, error) {
+	latest, _, err := latestVersion(db.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := open(db)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Close()
+
+	version, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, fmt.Errorf("read version of %s: %w", db.Name, err)
+	}
+	return &Status{Version: version, Latest: latest, Dirty: dirty}, nil
+}
+
+// Latest 迁移目录中最新的版本
+func Latest(dir string) (uint, error) {
+	latest, _, err := latestVersion(dir)
+	return latest, err
+}
+
+// Querier 可以执行查询的数据库连接，pgxpool.Pool 和 pgx.Tx 都满足
+type Querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// CurrentVersion 通过已有的连接池读取 golang-migrate 记录的版本，供健康检查使用，不会新建连接
+func CurrentVersion(ctx context.Context, q Querier) (version uint, dirty bool, err error) {
+	var v int64
+	err = q.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&v, &dirty)
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return 0, false, nil
+	case errors.As(err, &pgErr) && pgErr.Code == undefinedTable:
+		// 尚未执行过任何迁移
+		return 0, false, nil
+	case err != nil:
+		return 0, false, err
+	}
+	return uint(v), dirty, nil
+}
+
+// Create 在 dir 中创建下一个序号的空迁移文件，序号位数沿用目录中已有的文件，返回创建的 up / down 文件路径
+func Create(dir, name string) (up, down string, err error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !namePattern.MatchString(name) {
+		return "", "", ErrInvalidName
+	}
+
+	latest, width, err := latestVersion(dir)
+	if err != nil {
+		return "", "", err
+	}
+	if width == 0 {
+		width = 3
+	}
+
+	prefix := fmt.Sprintf("%0*d_%s", width, latest+1, name)
+	up = filepath.Join(dir, prefix+".up.sql")
+	down = filepath.Join(dir, prefix+".down.sql")
+	header := fmt.Sprintf("-- Migration %0*d: %s\n", width, latest+1, strings.ReplaceAll(name, "_", " "))
+	if err := writeNew(up, header); err != nil {
+		return "", "", err
+	}
+	if err := writeNew(down, header); err != nil {
+		os.Remove(up)
+		return "", "", err
+	}
+	return up, down, nil
+}
+
+// latestVersion 返回目录中最大的迁移序号及其位数
+func latestVersion(dir string) (latest uint, width int, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, e := range entries {
+		match := fileNamePattern.FindStringSubmatch(e.Name())
+		if match == nil {
+			continue
+		}
+		v, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if uint(v) >= latest {
+			latest, width = uint(v), len(match[1])
+		}
+	}
+	return latest, width, nil
+}
+
+// writeNew 创建文件，已存在时报错，避免覆盖已有迁移
+func writeNew(path, content string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,70 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	logdb "github.com/shiqi/datai/backend/db/log" // sqlc 生成的包
+)
+
+type Repository struct {
+	q    *logdb.Queries
+	pool *pgxpool.Pool
+}
+
+func NewRepository(q *logdb.Queries, pool *pgxpool.Pool) *Repository {
+	return &Repository{q: q, pool: pool}
+}
+
+// WithTx 在同一个事务中执行 fn，fn 返回错误时回滚
+func (r *Repository) WithTx(ctx context.Context, fn func(txRepo *Repository) error) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return fn(&Repository{q: r.q.WithTx(tx), pool: r.pool})
+	})
+}
+
+func (r *Repository) CreateNotification(ctx context.Context, arg logdb.CreateNotificationParams) (*logdb.Notification, error) {
+	n, err := r.q.CreateNotification(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (r *Repository) GetNotificationByDedupKey(ctx context.Context, dedupKey pgtype.Text) (*logdb.Notification, error) {
+	n, err := r.q.GetNotificationByDedupKey(ctx, dedupKey)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (r *Repository) GetNotification(ctx context.Context, id, userID int64) (*logdb.Notification, error) {
+	n, err := r.q.GetNotification(ctx, logdb.GetNotificationParams{ID: id, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (r *Repository) ListNotifications(ctx context.Context, arg logdb.ListNotificationsParams) ([]logdb.Notification, error) {
+	return r.q.ListNotifications(ctx, arg)
+}
+
+func (r *Repository) CountUnread(ctx context.Context, userID int64) (int64, error) {
+	return r.q.CountUnreadNotifications(ctx, userID)
+}
+
+func (r *Repository) MarkRead(ctx context.Context, id, userID int64) (*logdb.Notification, error) {
+	n, err := r.q.MarkNotificationRead(ctx, logdb.MarkNotificationReadParams{ID: id, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (r *Repository) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	return r.q.MarkAllNotificationsRead(ctx, userID)
+}
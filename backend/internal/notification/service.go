@@ -0,0 +1,221 @@
+package notification
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	logdb "github.com/shiqi/datai/backend/db/log"
+	"github.com/shiqi/datai/backend/internal/bus"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// 通知渠道，对应 notifications.channel_type 的 CHECK 约束
+const (
+	ChannelInApp = "in_app"
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelPush  = "push"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var (
+	ErrTypeRequired         = errors.New("notification type is required")
+	ErrInvalidChannel       = errors.New("channel must be one of in_app, email, sms, push")
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrInvalidCursor        = errors.New("invalid cursor")
+)
+
+// Input 一条待发送的通知，UserID 为 user_db 中的 users.id
+type Input struct {
+	UserID  int64
+	Type    string
+	Data    map[string]any // 序列化为 JSON 存入 content
+	Channel string         // 为空时为站内通知
+
+	// DedupKey 非空时同一个 key 只写入一次，重复投递返回已有的通知，供至少投递一次的来源（outbox）使用
+	DedupKey string
+}
+
+// Enqueuer 供其他模块投递通知
+type Enqueuer interface {
+	Enqueue(ctx context.Context, input Input) (*logdb.Notification, error)
+}
+
+// Page 一页通知，按时间倒序
+type Page struct {
+	Notifications []logdb.Notification
+	HasNextPage   bool
+	EndCursor     string
+	UnreadCount   int64
+}
+
+type Service struct {
+	notificationRepo *Repository
+	bus              bus.Bus
+}
+
+var _ Enqueuer = (*Service)(nil)
+
+// NewService b 为 nil 时使用进程内的 Memory
+func NewService(notificationRepo *Repository, b bus.Bus) *Service {
+	if b == nil {
+		b = bus.NewMemory()
+	}
+	return &Service{notificationRepo: notificationRepo, bus: b}
+}
+
+// Topic 用户新通知的推送主题
+func Topic(userID int64) string {
+	return fmt.Sprintf("notification:%d", userID)
+}
+
+func (s *Service) Enqueue(ctx context.Context, input Input) (*logdb.Notification, error) {
+	if input.Type == "" {
+		return nil, ErrTypeRequired
+	}
+	channel := input.Channel
+	if channel == "" {
+		channel = ChannelInApp
+	}
+	if !isValidChannel(channel) {
+		return nil, ErrInvalidChannel
+	}
+
+	content := []byte("{}")
+	if input.Data != nil {
+		var err error
+		if content, err = json.Marshal(input.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	dedupKey := pgtype.Text{String: input.DedupKey, Valid: input.DedupKey != ""}
+	n, err := s.notificationRepo.CreateNotification(ctx, logdb.CreateNotificationParams{
+		UserID:      input.UserID,
+		Type:        input.Type,
+		Content:     string(content),
+		ChannelType: channel,
+		DedupKey:    dedupKey,
+	})
+	if errors.Is(err, pgx.ErrNoRows) && dedupKey.Valid {
+		// 已经投递过，不再重复推送
+		return s.notificationRepo.GetNotificationByDedupKey(ctx, dedupKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 推送失败不影响通知落库，客户端重新拉取列表即可
+	if err := bus.PublishID(ctx, s.bus, Topic(n.UserID), n.ID); err != nil {
+		middleware.Logger(ctx).Warn("failed to publish notification", "notification_id", n.ID, "user_id", n.UserID, "error", err)
+	}
+	return n, nil
+}
+
+// Notify 投递一条站内通知，满足 user.Notifier
+func (s *Service) Notify(ctx context.Context, userID int64, kind, dedupKey string, data map[string]any) error {
+	_, err := s.Enqueue(ctx, Input{UserID: userID, Type: kind, Data: data, DedupKey: dedupKey})
+	return err
+}
+
+// List 分页返回用户的通知，unreadOnly 时只返回未读
+func (s *Service) List(ctx context.Context, userID int64, first int32, after string, unreadOnly bool) (*Page, error) {
+	if first <= 0 {
+		first = defaultPageSize
+	}
+	if first > maxPageSize {
+		first = maxPageSize
+	}
+
+	arg := logdb.ListNotificationsParams{
+		UserID:     userID,
+		UnreadOnly: unreadOnly,
+		Limit:      first + 1, // 多取一条判断是否还有下一页
+	}
+	if after != "" {
+		id, err := decodeCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		arg.AfterID = pgtype.Int8{Int64: id, Valid: true}
+	}
+
+	items, err := s.notificationRepo.ListNotifications(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &Page{UnreadCount: unread}
+	if int32(len(items)) > first {
+		page.HasNextPage = true
+		items = items[:first]
+	}
+	page.Notifications = items
+	if len(items) > 0 {
+		page.EndCursor = Cursor(items[len(items)-1])
+	}
+	return page, nil
+}
+
+func (s *Service) UnreadCount(ctx context.Context, userID int64) (int64, error) {
+	return s.notificationRepo.CountUnread(ctx, userID)
+}
+
+func (s *Service) MarkRead(ctx context.Context, userID, notificationID int64) (*logdb.Notification, error) {
+	n, err := s.notificationRepo.MarkRead(ctx, notificationID, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotificationNotFound
+	}
+	return n, err
+}
+
+// Subscribe 推送用户的新通知，ctx 结束时关闭 channel
+func (s *Service) Subscribe(ctx context.Context, userID int64) (<-chan *logdb.Notification, error) {
+	return bus.Relay(ctx, s.bus, Topic(userID), func(ctx context.Context, id int64) (*logdb.Notification, error) {
+		return s.notificationRepo.GetNotification(ctx, id, userID)
+	})
+}
+
+// MarkAllRead 把用户的全部未读通知标记为已读，返回标记的条数
+func (s *Service) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	return s.notificationRepo.MarkAllRead(ctx, userID)
+}
+
+// Cursor 返回通知的不透明分页游标
+func Cursor(n logdb.Notification) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(n.ID, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	return id, nil
+}
+
+func isValidChannel(channel string) bool {
+	switch channel {
+	case ChannelInApp, ChannelEmail, ChannelSMS, ChannelPush:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// 事务性 outbox：跨数据库的副作用（例如 events_db 的评分要更新 user_db 的汇总）
+// 先在源数据库与业务写入同一事务中插入 outbox 记录，再由 Relay 在后台投递
+// 投递至少一次，处理函数必须幂等：按来源重新计算，或用消息 ID 去重
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 20
+	defaultMaxAttempts  = 10
+	defaultLease        = time.Minute
+	maxRetryDelay       = 10 * time.Minute
+)
+
+// Message 一条待投递的 outbox 记录
+type Message struct {
+	ID       int64
+	Kind     string
+	Payload  []byte // JSON
+	Attempts int32  // 包含本次在内的投递次数
+}
+
+// Handler 处理一类消息，返回错误时按退避重试
+type Handler func(ctx context.Context, msg Message) error
+
+// Store 某个数据库中 outbox 表的读写，由各模块的 Repository 实现
+type Store interface {
+	// ClaimOutbox 认领最多 batchSize 条到期且未超过重试次数的消息，lease 内不会被其他实例重复认领
+	ClaimOutbox(ctx context.Context, batchSize, maxAttempts int32, lease time.Duration) ([]Message, error)
+	CompleteOutbox(ctx context.Context, id int64) error
+	RetryOutbox(ctx context.Context, id int64, lastErr string, delay time.Duration) error
+}
+
+// Options Relay 的可配置项，零值使用默认配置
+type Options struct {
+	PollInterval time.Duration
+	BatchSize    int32
+	MaxAttempts  int32 // 超过后不再重试，保留 last_error 等待人工处理
+	Lease        time.Duration
+}
+
+type Relay struct {
+	name     string
+	store    Store
+	handlers map[string]Handler
+	opts     Options
+}
+
+// NewRelay name 只用于日志，一般是源数据库名
+func NewRelay(name string, store Store, opts Options) *Relay {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.Lease <= 0 {
+		opts.Lease = defaultLease
+	}
+	return &Relay{name: name, store: store, handlers: make(map[string]Handler), opts: opts}
+}
+
+// Handle 注册 kind 对应的处理函数，需要在 Run 之前调用
+func (r *Relay) Handle(kind string, h Handler) {
+	r.handlers[kind] = h
+}
+
+// Run 轮询并投递 outbox，直到 ctx 结束
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// 一批满了说明可能还有积压，立即继续
+		for {
+			n, err := r.processBatch(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("outbox failed to claim messages", "outbox", r.name, "error", err)
+				}
+				break
+			}
+			if n < int(r.opts.BatchSize) {
+				break
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Relay) processBatch(ctx context.Context) (int, error) {
+	msgs, err := r.store.ClaimOutbox(ctx, r.opts.BatchSize, r.opts.MaxAttempts, r.opts.Lease)
+	if err != nil {
+		return 0, err
+	}
+	for _, msg := range msgs {
+		r.deliver(ctx, msg)
+	}
+	return len(msgs), nil
+}
+
+func (r *Relay) deliver(ctx context.Context, msg Message) {
+	err := r.handle(ctx, msg)
+	if err == nil {
+		if err := r.store.CompleteOutbox(ctx, msg.ID); err != nil {
+			// 租约到期后会被重新投递，依赖处理函数的幂等性
+			slog.Error("outbox failed to complete message", "outbox", r.name, "id", msg.ID, "kind", msg.Kind, "error", err)
+		}
+		return
+	}
+
+	if msg.Attempts >= r.opts.MaxAttempts {
+		slog.Error("outbox message gave up", "outbox", r.name, "id", msg.ID, "kind", msg.Kind, "attempts", msg.Attempts, "error", err)
+	} else {
+		slog.Warn("outbox message failed, will retry", "outbox", r.name, "id", msg.ID, "kind", msg.Kind, "attempts", msg.Attempts, "error", err)
+	}
+	if err := r.store.RetryOutbox(ctx, msg.ID, err.Error(), retryDelay(msg.Attempts)); err != nil {
+		slog.Error("outbox failed to reschedule message", "outbox", r.name, "id", msg.ID, "kind", msg.Kind, "error", err)
+	}
+}
+
+func (r *Relay) handle(ctx context.Context, msg Message) (err error) {
+	h, ok := r.handlers[msg.Kind]
+	if !ok {
+		return fmt.Errorf("no handler for kind %q", msg.Kind)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("handler panic: %v", p)
+		}
+	}()
+	return h(ctx, msg)
+}
+
+// retryDelay 指数退避：1s、2s、4s……，最长 10 分钟
+func retryDelay(attempts int32) time.Duration {
+	if attempts > 10 {
+		return maxRetryDelay
+	}
+	return min(time.Duration(1<<max(attempts-1, 0))*time.Second, maxRetryDelay)
+}
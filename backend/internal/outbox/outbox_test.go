@@ -0,0 +1,328 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memStore 内存中的 Store，按 ClaimOutbox / RetryOutbox 的 SQL 语义维护 attempts 和 available_at，
+// 时间由测试通过 advance 推进
+type memStore struct {
+	mu          sync.Mutex
+	now         time.Time
+	rows        []*memRow
+	completeErr error // 不为空时 CompleteOutbox 失败一次
+	retries     []time.Duration
+}
+
+type memRow struct {
+	msg         Message
+	availableAt time.Time
+	processed   bool
+	lastErr     string
+}
+
+func newMemStore() *memStore {
+	return &memStore{now: time.Unix(1_700_000_000, 0)}
+}
+
+func (s *memStore) enqueue(kind, payload string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := int64(len(s.rows) + 1)
+	s.rows = append(s.rows, &memRow{msg: Message{ID: id, Kind: kind, Payload: []byte(payload)}, availableAt: s.now})
+	return id
+}
+
+func (s *memStore) advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = s.now.Add(d)
+}
+
+func (s *memStore) row(id int64) memRow {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.rows[id-1]
+}
+
+func (s *memStore) ClaimOutbox(_ context.Context, batchSize, maxAttempts int32, lease time.Duration) ([]Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var msgs []Message
+	for _, r := range s.rows {
+		if len(msgs) == int(batchSize) {
+			break
+		}
+		if r.processed || r.availableAt.After(s.now) || r.msg.Attempts >= maxAttempts {
+			continue
+		}
+		r.msg.Attempts++
+		r.availableAt = s.now.Add(lease)
+		msgs = append(msgs, r.msg)
+	}
+	return msgs, nil
+}
+
+func (s *memStore) CompleteOutbox(_ context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.completeErr; err != nil {
+		s.completeErr = nil
+		return err
+	}
+	r := s.rows[id-1]
+	r.processed = true
+	r.lastErr = ""
+	return nil
+}
+
+func (s *memStore) RetryOutbox(_ context.Context, id int64, lastErr string, delay time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.rows[id-1]
+	r.lastErr = lastErr
+	r.availableAt = s.now.Add(delay)
+	s.retries = append(s.retries, delay)
+	return nil
+}
+
+// claimAndDeliver 认领并投递一批，返回投递的条数
+func claimAndDeliver(t *testing.T, r *Relay) int {
+	t.Helper()
+	n, err := r.processBatch(context.Background())
+	if err != nil {
+		t.Fatalf("processBatch() error = %v", err)
+	}
+	return n
+}
+
+func TestRelayDelivers(t *testing.T) {
+	store := newMemStore()
+	relay := NewRelay("test", store, Options{})
+	var got []string
+	relay.Handle("greet", func(_ context.Context, msg Message) error {
+		got = append(got, string(msg.Payload))
+		return nil
+	})
+	id := store.enqueue("greet", `{"name":"alice"}`)
+
+	if n := claimAndDeliver(t, relay); n != 1 {
+		t.Fatalf("delivered %d messages, want 1", n)
+	}
+	if len(got) != 1 || got[0] != `{"name":"alice"}` {
+		t.Fatalf("handler got %q", got)
+	}
+	if !store.row(id).processed {
+		t.Fatal("message not completed")
+	}
+
+	store.advance(time.Hour)
+	if n := claimAndDeliver(t, relay); n != 0 {
+		t.Fatalf("completed message claimed again (%d)", n)
+	}
+}
+
+func TestRelayRetriesWithBackoff(t *testing.T) {
+	store := newMemStore()
+	relay := NewRelay("test", store, Options{MaxAttempts: 5})
+	var attempts []int32
+	relay.Handle("flaky", func(_ context.Context, msg Message) error {
+		attempts = append(attempts, msg.Attempts)
+		if msg.Attempts < 3 {
+			return errors.New("user_db unavailable")
+		}
+		return nil
+	})
+	id := store.enqueue("flaky", `{}`)
+
+	claimAndDeliver(t, relay)
+	if row := store.row(id); row.processed || row.lastErr != "user_db unavailable" {
+		t.Fatalf("after first failure: processed %v, last error %q", row.processed, row.lastErr)
+	}
+
+	// 退避时间未到时不会重新认领
+	store.advance(500 * time.Millisecond)
+	if n := claimAndDeliver(t, relay); n != 0 {
+		t.Fatalf("message claimed %d times before its retry delay", n)
+	}
+	store.advance(500 * time.Millisecond)
+	claimAndDeliver(t, relay)
+
+	store.advance(time.Second)
+	if n := claimAndDeliver(t, relay); n != 0 {
+		t.Fatal("second retry must wait 2s")
+	}
+	store.advance(time.Second)
+	claimAndDeliver(t, relay)
+
+	if want := []int32{1, 2, 3}; !slices.Equal(attempts, want) {
+		t.Fatalf("handler attempts = %v, want %v", attempts, want)
+	}
+	if want := []time.Duration{time.Second, 2 * time.Second}; !slices.Equal(store.retries, want) {
+		t.Fatalf("retry delays = %v, want %v", store.retries, want)
+	}
+	if row := store.row(id); !row.processed || row.lastErr != "" {
+		t.Fatalf("after success: processed %v, last error %q", row.processed, row.lastErr)
+	}
+}
+
+func TestRelayGivesUpAfterMaxAttempts(t *testing.T) {
+	store := newMemStore()
+	relay := NewRelay("test", store, Options{MaxAttempts: 3})
+	calls := 0
+	relay.Handle("broken", func(context.Context, Message) error {
+		calls++
+		return errors.New("permanent failure")
+	})
+	id := store.enqueue("broken", `{}`)
+
+	for range 10 {
+		claimAndDeliver(t, relay)
+		store.advance(maxRetryDelay)
+	}
+	if calls != 3 {
+		t.Fatalf("handler called %d times, want 3", calls)
+	}
+	row := store.row(id)
+	if row.processed {
+		t.Fatal("failed message marked as processed")
+	}
+	if row.msg.Attempts != 3 || row.lastErr != "permanent failure" {
+		t.Fatalf("attempts %d, last error %q; want 3 and the handler error kept for manual handling", row.msg.Attempts, row.lastErr)
+	}
+}
+
+func TestRelayRedeliversWhenCompleteFails(t *testing.T) {
+	store := newMemStore()
+	store.completeErr = errors.New("connection reset")
+	lease := time.Minute
+	relay := NewRelay("test", store, Options{Lease: lease})
+
+	// 幂等的处理函数：按消息 ID 去重，重复投递不会重复生效
+	applied := map[int64]int{}
+	calls := 0
+	relay.Handle("credit", func(_ context.Context, msg Message) error {
+		calls++
+		if _, ok := applied[msg.ID]; !ok {
+			applied[msg.ID] = 1
+		}
+		return nil
+	})
+	id := store.enqueue("credit", `{}`)
+
+	claimAndDeliver(t, relay)
+	if store.row(id).processed {
+		t.Fatal("message completed although CompleteOutbox failed")
+	}
+	if len(store.retries) != 0 {
+		t.Fatalf("handler succeeded, message must not be rescheduled as a failure: %v", store.retries)
+	}
+
+	// 租约内不会被重复认领
+	store.advance(lease - time.Second)
+	if n := claimAndDeliver(t, relay); n != 0 {
+		t.Fatal("message claimed again within its lease")
+	}
+	store.advance(time.Second)
+	claimAndDeliver(t, relay)
+
+	if calls != 2 {
+		t.Fatalf("handler called %d times, want 2 (redelivered after the lease)", calls)
+	}
+	if applied[id] != 1 {
+		t.Fatalf("effect applied %d times, want once", applied[id])
+	}
+	if !store.row(id).processed {
+		t.Fatal("message not completed after redelivery")
+	}
+}
+
+func TestRelayHandlerErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		wantErr string
+	}{
+		{name: "no handler", kind: "unknown", wantErr: `no handler for kind "unknown"`},
+		{name: "panic", kind: "panics", wantErr: "handler panic: boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemStore()
+			relay := NewRelay("test", store, Options{})
+			relay.Handle("panics", func(context.Context, Message) error { panic("boom") })
+			id := store.enqueue(tt.kind, `{}`)
+
+			claimAndDeliver(t, relay)
+			row := store.row(id)
+			if row.processed || !strings.Contains(row.lastErr, tt.wantErr) {
+				t.Fatalf("processed %v, last error %q, want %q", row.processed, row.lastErr, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRelayBatchesBacklog(t *testing.T) {
+	store := newMemStore()
+	relay := NewRelay("test", store, Options{BatchSize: 2, PollInterval: time.Hour})
+	var mu sync.Mutex
+	delivered := 0
+	done := make(chan struct{})
+	relay.Handle("n", func(context.Context, Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if delivered++; delivered == 5 {
+			close(done)
+		}
+		return nil
+	})
+	for range 5 {
+		store.enqueue("n", `{}`)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(stopped)
+	}()
+
+	// 积压的消息不等下一次轮询，连续按批投递完
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("backlog not drained without waiting for the poll interval")
+	}
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after ctx was cancelled")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{10, 512 * time.Second},
+		{11, maxRetryDelay},
+		{100, maxRetryDelay},
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	tenantdb "github.com/shiqi/datai/backend/db/tenant" // sqlc 生成的包
+)
+
+type Repository struct {
+	q    *tenantdb.Queries
+	pool *pgxpool.Pool
+}
+
+func NewRepository(q *tenantdb.Queries, pool *pgxpool.Pool) *Repository {
+	return &Repository{q: q, pool: pool}
+}
+
+// WithTx 在同一个事务中执行 fn，fn 返回错误时回滚
+func (r *Repository) WithTx(ctx context.Context, fn func(txRepo *Repository) error) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return fn(&Repository{q: r.q.WithTx(tx), pool: r.pool})
+	})
+}
+
+func (r *Repository) GetTenantByID(ctx context.Context, id string) (*tenantdb.Tenant, error) {
+	t, err := r.q.GetTenantByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *Repository) CreateTenant(ctx context.Context, arg tenantdb.CreateTenantParams) (*tenantdb.Tenant, error) {
+	t, err := r.q.CreateTenant(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *Repository) LockTenant(ctx context.Context, id string) error {
+	return r.q.LockTenant(ctx, id)
+}
+
+func (r *Repository) GetMember(ctx context.Context, tenantID string, userID int64) (*tenantdb.UserTenant, error) {
+	m, err := r.q.GetTenantMember(ctx, tenantdb.GetTenantMemberParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *Repository) AddMember(ctx context.Context, tenantID string, userID int64, role string) (*tenantdb.UserTenant, error) {
+	m, err := r.q.AddTenantMember(ctx, tenantdb.AddTenantMemberParams{
+		UserID:   userID,
+		TenantID: tenantID,
+		Role:     role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *Repository) UpdateMemberRole(ctx context.Context, tenantID string, userID int64, role string) (*tenantdb.UserTenant, error) {
+	m, err := r.q.UpdateTenantMemberRole(ctx, tenantdb.UpdateTenantMemberRoleParams{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *Repository) RemoveMember(ctx context.Context, tenantID string, userID int64) error {
+	return r.q.RemoveTenantMember(ctx, tenantdb.RemoveTenantMemberParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+}
+
+func (r *Repository) CountMembersByRole(ctx context.Context, tenantID, role string) (int64, error) {
+	return r.q.CountTenantMembersByRole(ctx, tenantdb.CountTenantMembersByRoleParams{
+		TenantID: tenantID,
+		Role:     role,
+	})
+}
+
+func (r *Repository) ListMembers(ctx context.Context, tenantID string) ([]tenantdb.UserTenant, error) {
+	return r.q.ListTenantMembers(ctx, tenantID)
+}
+
+func (r *Repository) ListUserTenants(ctx context.Context, userID int64) ([]tenantdb.ListUserTenantsRow, error) {
+	return r.q.ListUserTenants(ctx, userID)
+}
+
+func (r *Repository) ListUserTenantIDs(ctx context.Context, userID int64) ([]string, error) {
+	ids, err := r.q.ListUserTenantIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+	return ids, nil
+}
@@ -0,0 +1,310 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	tenantdb "github.com/shiqi/datai/backend/db/tenant"
+	"github.com/shiqi/datai/backend/internal/user"
+)
+
+// 租户成员角色，对应 user_tenants.role
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+// roleRank 角色高低，高角色拥有低角色的全部权限
+var roleRank = map[string]int{
+	RoleMember: 1,
+	RoleAdmin:  2,
+}
+
+const (
+	maxTenantIDLength = 64
+	maxNameLength     = 100
+	maxRegionLength   = 10
+)
+
+var (
+	ErrInvalidTenantID = errors.New("tenant id is required and must be at most 64 characters")
+	ErrNameRequired    = errors.New("tenant name is required and must be at most 100 characters")
+	ErrInvalidRegion   = errors.New("tenant region is required and must be at most 10 characters")
+	ErrTenantExists    = errors.New("tenant id is already taken")
+	ErrTenantNotFound  = errors.New("tenant not found")
+	ErrInvalidRole     = errors.New("role must be one of admin, member")
+	ErrNotTenantAdmin  = errors.New("only tenant admins can manage members")
+	ErrAlreadyMember   = errors.New("user is already a member of this tenant")
+	ErrNotMember       = errors.New("user is not a member of this tenant")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrLastAdmin       = errors.New("a tenant must keep at least one admin")
+)
+
+type Service struct {
+	tenantRepo  *Repository
+	userService *user.Service
+}
+
+func NewService(tenantRepo *Repository, userService *user.Service) *Service {
+	return &Service{tenantRepo: tenantRepo, userService: userService}
+}
+
+type CreateTenantInput struct {
+	ID       string
+	Name     string
+	Region   string
+	OwnerUID string
+}
+
+// CreateTenant 创建租户，创建者自动成为 admin
+func (s *Service) CreateTenant(ctx context.Context, input CreateTenantInput) (*tenantdb.Tenant, error) {
+	id := strings.TrimSpace(input.ID)
+	if id == "" || len(id) > maxTenantIDLength {
+		return nil, ErrInvalidTenantID
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return nil, ErrNameRequired
+	}
+	region := strings.TrimSpace(input.Region)
+	if region == "" || len(region) > maxRegionLength {
+		return nil, ErrInvalidRegion
+	}
+
+	owner, err := s.userService.GetUserByUID(ctx, input.OwnerUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *tenantdb.Tenant
+	err = s.tenantRepo.WithTx(ctx, func(txRepo *Repository) error {
+		if _, err := txRepo.GetTenantByID(ctx, id); err == nil {
+			return ErrTenantExists
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		if result, err = txRepo.CreateTenant(ctx, tenantdb.CreateTenantParams{
+			ID:     id,
+			Name:   name,
+			Region: region,
+		}); err != nil {
+			return err
+		}
+		_, err = txRepo.AddMember(ctx, result.ID, owner.ID, RoleAdmin)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *Service) GetTenant(ctx context.Context, tenantID string) (*tenantdb.Tenant, error) {
+	t, err := s.tenantRepo.GetTenantByID(ctx, tenantID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTenantNotFound
+	}
+	return t, err
+}
+
+// AddMember 由租户 admin 把已注册的用户加入租户。
+// user_tenants.user_id 指向 user_db 中的 users.id，跨库无法用外键约束，这里先通过用户服务确认用户存在。
+func (s *Service) AddMember(ctx context.Context, tenantID string, userID int64, role, actorUID string) (*tenantdb.UserTenant, error) {
+	if !IsValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+	if err := s.requireAdmin(ctx, tenantID, actorUID); err != nil {
+		return nil, err
+	}
+	if _, err := s.userService.GetUserByID(ctx, userID); errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var result *tenantdb.UserTenant
+	err := s.tenantRepo.WithTx(ctx, func(txRepo *Repository) error {
+		if err := txRepo.LockTenant(ctx, tenantID); err != nil {
+			return err
+		}
+		if _, err := txRepo.GetMember(ctx, tenantID, userID); err == nil {
+			return ErrAlreadyMember
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		var err error
+		result, err = txRepo.AddMember(ctx, tenantID, userID, role)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ChangeMemberRole 修改成员角色；不能把租户最后一个 admin 降级
+func (s *Service) ChangeMemberRole(ctx context.Context, tenantID string, userID int64, role, actorUID string) (*tenantdb.UserTenant, error) {
+	if !IsValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+	if err := s.requireAdmin(ctx, tenantID, actorUID); err != nil {
+		return nil, err
+	}
+
+	var result *tenantdb.UserTenant
+	err := s.tenantRepo.WithTx(ctx, func(txRepo *Repository) error {
+		existing, err := txRepo.lockMember(ctx, tenantID, userID)
+		if err != nil {
+			return err
+		}
+		if existing.Role == RoleAdmin && role != RoleAdmin {
+			if err := txRepo.ensureAnotherAdmin(ctx, tenantID); err != nil {
+				return err
+			}
+		}
+		result, err = txRepo.UpdateMemberRole(ctx, tenantID, userID, role)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveMember 移除成员；admin 可以移除任何人，普通成员只能退出自己
+func (s *Service) RemoveMember(ctx context.Context, tenantID string, userID int64, actorUID string) error {
+	actor, err := s.userService.GetUserByUID(ctx, actorUID)
+	if err != nil {
+		return err
+	}
+	if actor.ID != userID {
+		if err := s.requireAdmin(ctx, tenantID, actorUID); err != nil {
+			return err
+		}
+	}
+
+	return s.tenantRepo.WithTx(ctx, func(txRepo *Repository) error {
+		existing, err := txRepo.lockMember(ctx, tenantID, userID)
+		if err != nil {
+			return err
+		}
+		if existing.Role == RoleAdmin {
+			if err := txRepo.ensureAnotherAdmin(ctx, tenantID); err != nil {
+				return err
+			}
+		}
+		return txRepo.RemoveMember(ctx, tenantID, userID)
+	})
+}
+
+// ListMembers 列出租户成员，只有租户成员和平台管理员可以查看
+func (s *Service) ListMembers(ctx context.Context, tenantID, viewerUID string) ([]tenantdb.UserTenant, error) {
+	if _, err := s.GetTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	ok, err := s.HasRole(ctx, tenantID, viewerUID, RoleMember)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotMember
+	}
+	return s.tenantRepo.ListMembers(ctx, tenantID)
+}
+
+// MyTenants 当前用户加入的所有租户
+func (s *Service) MyTenants(ctx context.Context, uid string) ([]tenantdb.ListUserTenantsRow, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return s.tenantRepo.ListUserTenants(ctx, u.ID)
+}
+
+// TenantIDsForUser 返回用户所属的租户 ID，供请求中间件做租户校验和活动过滤。
+// 尚未在 user_db 建档的用户视为没有加入任何租户。
+func (s *Service) TenantIDsForUser(ctx context.Context, uid string) ([]string, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.tenantRepo.ListUserTenantIDs(ctx, u.ID)
+}
+
+// HasRole 判断用户在租户中的角色是否不低于 role，平台管理员视为具备所有租户的所有角色
+func (s *Service) HasRole(ctx context.Context, tenantID, uid, role string) (bool, error) {
+	u, err := s.userService.GetUserByUID(ctx, uid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if u.IsPlatformAdmin {
+		return true, nil
+	}
+
+	member, err := s.tenantRepo.GetMember(ctx, tenantID, u.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return roleRank[member.Role] >= roleRank[role], nil
+}
+
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleAdmin, RoleMember:
+		return true
+	}
+	return false
+}
+
+func (s *Service) requireAdmin(ctx context.Context, tenantID, uid string) error {
+	if _, err := s.GetTenant(ctx, tenantID); err != nil {
+		return err
+	}
+	ok, err := s.HasRole(ctx, tenantID, uid, RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotTenantAdmin
+	}
+	return nil
+}
+
+// lockMember 锁定租户后读取成员记录，避免并发修改导致租户没有 admin
+func (r *Repository) lockMember(ctx context.Context, tenantID string, userID int64) (*tenantdb.UserTenant, error) {
+	if err := r.LockTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	member, err := r.GetMember(ctx, tenantID, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotMember
+	}
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (r *Repository) ensureAnotherAdmin(ctx context.Context, tenantID string) error {
+	count, err := r.CountMembersByRole(ctx, tenantID, RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return ErrLastAdmin
+	}
+	return nil
+}
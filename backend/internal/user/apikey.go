@@ -0,0 +1,223 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	userdb "github.com/shiqi/datai/backend/db/user"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// API key 格式为 dtk_<prefix>_<secret>：prefix 是 6 字节随机数的 hex，用于查找；secret 是 32 字节随机数
+// 库中只保存整个 key 的 SHA-256 摘要，明文只在创建时返回一次
+
+const (
+	maxActiveAPIKeys    = 20
+	maxAPIKeyNameLength = 100
+)
+
+var (
+	ErrAPIKeyNameRequired  = errors.New("api key name is required")
+	ErrAPIKeyNameTooLong   = errors.New("api key name must be at most 100 characters")
+	ErrAPIKeyScopeRequired = errors.New("api key needs at least one scope")
+	ErrInvalidAPIKeyScope  = errors.New("api key scope must be read or write")
+	ErrAPIKeyExpiryInPast  = errors.New("api key expiry must be in the future")
+	ErrTooManyAPIKeys      = errors.New("too many active api keys, revoke unused ones first")
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrInvalidAPIKey       = errors.New("invalid api key")
+	ErrAPIKeyRevoked       = errors.New("api key has been revoked")
+	ErrAPIKeyExpired       = errors.New("api key has expired")
+)
+
+type CreateAPIKeyInput struct {
+	Name      string
+	Scopes    []string
+	TenantID  string // 为空表示不绑定租户，调用方负责校验用户是该租户成员
+	ExpiresAt *time.Time
+}
+
+// CreateAPIKey 为用户创建 API key，返回记录和明文 key
+func (s *Service) CreateAPIKey(ctx context.Context, uid string, input CreateAPIKeyInput) (*userdb.ApiKey, string, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, "", ErrAPIKeyNameRequired
+	}
+	if utf8.RuneCountInString(name) > maxAPIKeyNameLength {
+		return nil, "", ErrAPIKeyNameTooLong
+	}
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, "", ErrAPIKeyExpiryInPast
+	}
+
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, "", err
+	}
+	active, err := s.userRepo.CountActiveAPIKeys(ctx, u.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	if active >= maxActiveAPIKeys {
+		return nil, "", ErrTooManyAPIKeys
+	}
+
+	prefix, key, err := generateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+	arg := userdb.CreateAPIKeyParams{
+		UserID:  u.ID,
+		Name:    name,
+		Prefix:  prefix,
+		KeyHash: hashAPIKey(key),
+		Scopes:  scopes,
+	}
+	if input.TenantID != "" {
+		arg.TenantID = pgtype.Text{String: input.TenantID, Valid: true}
+	}
+	if input.ExpiresAt != nil {
+		arg.ExpiresAt = pgtype.Timestamptz{Time: *input.ExpiresAt, Valid: true}
+	}
+
+	created, err := s.userRepo.CreateAPIKey(ctx, arg)
+	if err != nil {
+		return nil, "", err
+	}
+	return created, key, nil
+}
+
+// ListAPIKeys 用户的全部 API key（含已撤销和已过期），最新的在前
+func (s *Service) ListAPIKeys(ctx context.Context, uid string) ([]userdb.ApiKey, error) {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return []userdb.ApiKey{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.userRepo.ListAPIKeysByUser(ctx, u.ID)
+}
+
+// RevokeAPIKey 撤销本人的 API key，立即生效
+func (s *Service) RevokeAPIKey(ctx context.Context, uid string, id int64) (*userdb.ApiKey, error) {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.userRepo.RevokeAPIKey(ctx, id, u.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 不存在、不属于本人或已撤销
+		return nil, ErrAPIKeyNotFound
+	}
+	return revoked, err
+}
+
+// VerifyAPIKey 实现 middleware.APIKeyVerifier，并记录最近使用时间
+func (s *Service) VerifyAPIKey(ctx context.Context, key string) (*middleware.APIKeyPrincipal, error) {
+	prefix, ok := apiKeyLookupPrefix(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+	k, err := s.userRepo.GetAPIKeyByPrefix(ctx, prefix)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !apiKeyHashMatches(key, k.KeyHash) {
+		return nil, ErrInvalidAPIKey
+	}
+	if k.RevokedAt.Valid {
+		return nil, ErrAPIKeyRevoked
+	}
+	if k.ExpiresAt.Valid && !k.ExpiresAt.Time.After(time.Now()) {
+		return nil, ErrAPIKeyExpired
+	}
+
+	u, err := s.userRepo.GetUserByID(ctx, k.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.TouchAPIKey(ctx, k.ID); err != nil {
+		middleware.Logger(ctx).Warn("failed to record api key usage", "api_key_id", k.ID, "error", err)
+	}
+	return &middleware.APIKeyPrincipal{
+		KeyID:    k.ID,
+		UserID:   u.Uid,
+		TenantID: k.TenantID.String,
+		Scopes:   k.Scopes,
+	}, nil
+}
+
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, ErrAPIKeyScopeRequired
+	}
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if scope != middleware.ScopeRead && scope != middleware.ScopeWrite {
+			return nil, ErrInvalidAPIKeyScope
+		}
+		if !slices.Contains(result, scope) {
+			result = append(result, scope)
+		}
+	}
+	slices.Sort(result)
+	return result, nil
+}
+
+// generateAPIKey 返回用于查找的前缀（dtk_<prefix>）和完整的 key
+func generateAPIKey() (prefix, key string, err error) {
+	lookup := make([]byte, 6)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(lookup); err != nil {
+		return "", "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	prefix = middleware.APIKeyPrefix + hex.EncodeToString(lookup)
+	return prefix, prefix + "_" + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashAPIKey 库中保存的 key 摘要
+func hashAPIKey(key string) []byte {
+	hash := sha256.Sum256([]byte(key))
+	return hash[:]
+}
+
+// apiKeyHashMatches 以常量时间比较 key 的摘要，避免通过响应时间逐字节猜出摘要
+func apiKeyHashMatches(key string, stored []byte) bool {
+	return subtle.ConstantTimeCompare(hashAPIKey(key), stored) == 1
+}
+
+// apiKeyLookupPrefix 从完整的 key 中取出 dtk_<prefix> 部分
+func apiKeyLookupPrefix(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, middleware.APIKeyPrefix)
+	if !ok {
+		return "", false
+	}
+	lookup, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(lookup) != 12 || secret == "" {
+		return "", false
+	}
+	return middleware.APIKeyPrefix + lookup, true
+}
@@ -0,0 +1,120 @@
+package user
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	prefix, key, err := generateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !middleware.IsAPIKey(key) {
+		t.Errorf("key %q is not recognised as an api key", key)
+	}
+	if !strings.HasPrefix(key, prefix+"_") {
+		t.Errorf("key %q does not start with prefix %q", key, prefix)
+	}
+	got, ok := apiKeyLookupPrefix(key)
+	if !ok || got != prefix {
+		t.Errorf("apiKeyLookupPrefix(%q) = %q, %v, want %q", key, got, ok, prefix)
+	}
+
+	_, other, err := generateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == key {
+		t.Error("generateAPIKey returned the same key twice")
+	}
+}
+
+func TestAPIKeyLookupPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		want   string
+		wantOK bool
+	}{
+		{name: "valid", key: "dtk_0123456789ab_c2VjcmV0", want: "dtk_0123456789ab", wantOK: true},
+		{name: "secret may contain underscores", key: "dtk_0123456789ab_a_b", want: "dtk_0123456789ab", wantOK: true},
+		{name: "unknown prefix", key: "sk_0123456789ab_c2VjcmV0"},
+		{name: "prefix in wrong case", key: "DTK_0123456789ab_c2VjcmV0"},
+		{name: "jwt", key: "eyJhbGciOiJSUzI1NiJ9.e30.sig"},
+		{name: "empty", key: ""},
+		{name: "prefix only", key: "dtk_"},
+		{name: "missing secret separator", key: "dtk_0123456789ab"},
+		{name: "empty secret", key: "dtk_0123456789ab_"},
+		{name: "short lookup", key: "dtk_0123_c2VjcmV0"},
+		{name: "long lookup", key: "dtk_0123456789abcd_c2VjcmV0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := apiKeyLookupPrefix(tt.key)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("apiKeyLookupPrefix(%q) = %q, %v, want %q, %v", tt.key, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAPIKeyHashMatches(t *testing.T) {
+	_, key, err := generateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := hashAPIKey(key)
+
+	tests := []struct {
+		name   string
+		key    string
+		stored []byte
+		want   bool
+	}{
+		{name: "same key", key: key, stored: stored, want: true},
+		{name: "different secret", key: key[:len(key)-1] + "x", stored: stored},
+		{name: "key with extra suffix", key: key + "x", stored: stored},
+		{name: "empty key", key: "", stored: stored},
+		{name: "truncated stored hash", key: key, stored: stored[:16]},
+		{name: "no stored hash", key: key, stored: nil},
+		{name: "plaintext stored instead of hash", key: key, stored: []byte(key)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := apiKeyHashMatches(tt.key, tt.stored); got != tt.want {
+				t.Errorf("apiKeyHashMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		scopes  []string
+		want    []string
+		wantErr error
+	}{
+		{name: "read", scopes: []string{"read"}, want: []string{"read"}},
+		{name: "sorted and deduplicated", scopes: []string{"write", " READ ", "read"}, want: []string{"read", "write"}},
+		{name: "empty", scopes: nil, wantErr: ErrAPIKeyScopeRequired},
+		{name: "unknown scope", scopes: []string{"read", "admin"}, wantErr: ErrInvalidAPIKeyScope},
+		{name: "blank scope", scopes: []string{""}, wantErr: ErrInvalidAPIKeyScope},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeScopes(tt.scopes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("normalizeScopes() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("normalizeScopes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	userdb "github.com/shiqi/datai/backend/db/user"
+)
+
+// 认证类型，对应 user_certifications.cert_type 的 CHECK 约束
+const (
+	CertEducation  = "education"
+	CertEmployment = "employment"
+	CertSkill      = "skill"
+	CertOther      = "other"
+)
+
+// 认证状态，对应 user_certifications.status 的 CHECK 约束
+const (
+	CertPending  = "pending"
+	CertApproved = "approved"
+	CertRejected = "rejected"
+)
+
+// NotifyCertificationStatus 认证状态变化的通知类型
+const NotifyCertificationStatus = "certification_status"
+
+const (
+	defaultCertQueueSize = 20
+	maxCertQueueSize     = 100
+)
+
+var (
+	ErrInvalidCertType       = errors.New("certification type must be one of education, employment, skill, other")
+	ErrProofRequired         = errors.New("certification proof is required")
+	ErrCertEntryRequired     = errors.New("education and employment certifications must reference one of your entries")
+	ErrCertEntryNotAllowed   = errors.New("only education and employment certifications can reference an entry")
+	ErrCertificationPending  = errors.New("a certification for this entry is already pending review")
+	ErrCertificationNotFound = errors.New("certification not found")
+	ErrCertificationReviewed = errors.New("certification has already been reviewed")
+	ErrNotReviewer           = errors.New("only platform admins can review certifications")
+	ErrRejectReasonRequired  = errors.New("a reason is required when rejecting a certification")
+)
+
+// Notifier 向用户发送站内通知，由通知模块实现；dedupKey 相同的通知只写入一次
+type Notifier interface {
+	Notify(ctx context.Context, userID int64, kind, dedupKey string, data map[string]any) error
+}
+
+// NopNotifier 不发送任何通知
+type NopNotifier struct{}
+
+func (NopNotifier) Notify(context.Context, int64, string, string, map[string]any) error { return nil }
+
+type SubmitCertificationInput struct {
+	CertType     string
+	Proof        string
+	EducationID  *int64
+	EmploymentID *int64
+}
+
+// SubmitCertification 提交认证申请，education / employment 类型需要关联本人的对应履历
+func (s *Service) SubmitCertification(ctx context.Context, uid string, input SubmitCertificationInput) (*userdb.UserCertification, error) {
+	proof := strings.TrimSpace(input.Proof)
+	if proof == "" {
+		return nil, ErrProofRequired
+	}
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := userdb.CreateCertificationParams{
+		UserID:   u.ID,
+		CertType: input.CertType,
+		Proof:    pgtype.Text{String: proof, Valid: true},
+	}
+	switch input.CertType {
+	case CertEducation:
+		if input.EducationID == nil || input.EmploymentID != nil {
+			return nil, ErrCertEntryRequired
+		}
+		education, err := s.userRepo.GetEducationByID(ctx, *input.EducationID)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && education.UserID != u.ID) {
+			return nil, ErrEducationNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		arg.EducationID = pgtype.Int8{Int64: education.ID, Valid: true}
+	case CertEmployment:
+		if input.EmploymentID == nil || input.EducationID != nil {
+			return nil, ErrCertEntryRequired
+		}
+		employment, err := s.userRepo.GetEmploymentByID(ctx, *input.EmploymentID)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && employment.UserID != u.ID) {
+			return nil, ErrEmploymentNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		arg.EmploymentID = pgtype.Int8{Int64: employment.ID, Valid: true}
+	case CertSkill, CertOther:
+		if input.EducationID != nil || input.EmploymentID != nil {
+			return nil, ErrCertEntryNotAllowed
+		}
+	default:
+		return nil, ErrInvalidCertType
+	}
+
+	// 同一条履历同时只能有一个待审核的申请
+	if arg.EducationID.Valid || arg.EmploymentID.Valid {
+		pending, err := s.userRepo.CountPendingCertificationsForEntry(ctx, userdb.CountPendingCertificationsForEntryParams{
+			UserID:       u.ID,
+			CertType:     arg.CertType,
+			EducationID:  arg.EducationID,
+			EmploymentID: arg.EmploymentID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if pending > 0 {
+			return nil, ErrCertificationPending
+		}
+	}
+
+	var cert *userdb.UserCertification
+	err = s.userRepo.WithTx(ctx, func(txRepo *Repository) error {
+		if cert, err = txRepo.CreateCertification(ctx, arg); err != nil {
+			return err
+		}
+		return txRepo.enqueueCertificationNotice(ctx, cert)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// ApproveCertification 审核通过，并在同一事务中把关联履历标记为已认证
+func (s *Service) ApproveCertification(ctx context.Context, certID int64, reviewerUID, reason string) (*userdb.UserCertification, error) {
+	return s.reviewCertification(ctx, certID, reviewerUID, CertApproved, reason)
+}
+
+// RejectCertification 审核拒绝，必须给出原因
+func (s *Service) RejectCertification(ctx context.Context, certID int64, reviewerUID, reason string) (*userdb.UserCertification, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, ErrRejectReasonRequired
+	}
+	return s.reviewCertification(ctx, certID, reviewerUID, CertRejected, reason)
+}
+
+// CertificationQueue 待审核的认证，按提交顺序分页
+func (s *Service) CertificationQueue(ctx context.Context, reviewerUID string, first int32, afterID *int64) ([]userdb.UserCertification, error) {
+	if _, err := s.getReviewer(ctx, reviewerUID); err != nil {
+		return nil, err
+	}
+	if first <= 0 {
+		first = defaultCertQueueSize
+	}
+	if first > maxCertQueueSize {
+		first = maxCertQueueSize
+	}
+	arg := userdb.ListPendingCertificationsParams{Limit: first}
+	if afterID != nil {
+		arg.AfterID = pgtype.Int8{Int64: *afterID, Valid: true}
+	}
+	return s.userRepo.ListPendingCertifications(ctx, arg)
+}
+
+func (s *Service) ListMyCertifications(ctx context.Context, uid string) ([]userdb.UserCertification, error) {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return s.userRepo.ListUserCertifications(ctx, u.ID)
+}
+
+func (s *Service) reviewCertification(ctx context.Context, certID int64, reviewerUID, status, reason string) (*userdb.UserCertification, error) {
+	reviewer, err := s.getReviewer(ctx, reviewerUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *userdb.UserCertification
+	err = s.userRepo.WithTx(ctx, func(txRepo *Repository) error {
+		cert, err := txRepo.GetCertificationForUpdate(ctx, certID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCertificationNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if cert.Status.String != CertPending {
+			return ErrCertificationReviewed
+		}
+
+		if result, err = txRepo.ReviewCertification(ctx, userdb.ReviewCertificationParams{
+			ID:           cert.ID,
+			Status:       pgtype.Text{String: status, Valid: true},
+			ReviewerID:   pgtype.Int8{Int64: reviewer.ID, Valid: true},
+			ReviewReason: textOrNull(strings.TrimSpace(reason)),
+		}); err != nil {
+			return err
+		}
+		if err := txRepo.enqueueCertificationNotice(ctx, result); err != nil {
+			return err
+		}
+
+		if status != CertApproved {
+			return nil
+		}
+		switch {
+		case cert.EducationID.Valid:
+			return txRepo.SetEducationVerified(ctx, cert.EducationID.Int64, cert.UserID)
+		case cert.EmploymentID.Valid:
+			return txRepo.SetEmploymentVerified(ctx, cert.EmploymentID.Int64, cert.UserID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *Service) getReviewer(ctx context.Context, uid string) (*userdb.User, error) {
+	reviewer, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if !reviewer.IsPlatformAdmin {
+		return nil, ErrNotReviewer
+	}
+	return reviewer, nil
+}
+
+// enqueueCertificationNotice 在审核事务中写入 outbox，由 relay 通知申请人认证状态变化
+func (r *Repository) enqueueCertificationNotice(ctx context.Context, cert *userdb.UserCertification) error {
+	data := map[string]any{
+		"certificationId": cert.ID,
+		"certType":        cert.CertType,
+		"status":          cert.Status.String,
+	}
+	if cert.ReviewReason.Valid {
+		data["reason"] = cert.ReviewReason.String
+	}
+	return r.EnqueueOutbox(ctx, OutboxNotification, notificationMessage{
+		UserID: cert.UserID,
+		Kind:   NotifyCertificationStatus,
+		Data:   data,
+	})
+}
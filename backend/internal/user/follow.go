@@ -0,0 +1,293 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	userdb "github.com/shiqi/datai/backend/db/user"
+)
+
+const (
+	defaultFollowPageSize = 20
+	maxFollowPageSize     = 100
+)
+
+var (
+	ErrCannotFollowSelf = errors.New("cannot follow yourself")
+	ErrUserNotFound     = errors.New("user not found")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+)
+
+// FollowEdge 关注列表中的一项
+type FollowEdge struct {
+	User       userdb.User
+	FollowedAt pgtype.Timestamptz
+	Cursor     string
+}
+
+// FollowPage 一页关注/粉丝，按关注时间倒序
+type FollowPage struct {
+	Edges       []FollowEdge
+	HasNextPage bool
+	EndCursor   string
+}
+
+// FollowPageKey 关注/粉丝列表 DataLoader 的 key，由 NewFollowPageKey 构造
+type FollowPageKey struct {
+	UserID int64
+	First  int32
+	After  string
+}
+
+// NewFollowPageKey 规范化页大小并校验游标，使游标错误在合并查询前返回给对应的字段
+func NewFollowPageKey(userID int64, first int32, after string) (FollowPageKey, error) {
+	var (
+		limit   int32
+		afterAt pgtype.Timestamptz
+		afterID pgtype.Int8
+	)
+	first, err := applyFollowCursor(first, after, &limit, &afterAt, &afterID)
+	if err != nil {
+		return FollowPageKey{}, err
+	}
+	return FollowPageKey{UserID: userID, First: first, After: after}, nil
+}
+
+// Follow 关注用户，重复关注不报错
+func (s *Service) Follow(ctx context.Context, uid string, followeeID int64) (*userdb.User, error) {
+	follower, followee, err := s.getFollowPair(ctx, uid, followeeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.FollowUser(ctx, follower.ID, followee.ID); err != nil {
+		return nil, err
+	}
+	return followee, nil
+}
+
+// Unfollow 取消关注，未关注时不报错
+func (s *Service) Unfollow(ctx context.Context, uid string, followeeID int64) (*userdb.User, error) {
+	follower, followee, err := s.getFollowPair(ctx, uid, followeeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.UnfollowUser(ctx, follower.ID, followee.ID); err != nil {
+		return nil, err
+	}
+	return followee, nil
+}
+
+// ListFollowersByKeys 批量分页列出粉丝，供 DataLoader 使用；分页参数相同的 key 合并成一次查询
+func (s *Service) ListFollowersByKeys(ctx context.Context, keys []FollowPageKey) (map[FollowPageKey]*FollowPage, error) {
+	return batchFollowPages(keys, func(userIDs []int64, arg followPageArg) (map[int64][]FollowEdge, error) {
+		rows, err := s.userRepo.ListFollowersByUserIDs(ctx, userdb.ListFollowersByUserIDsParams{
+			UserIds:         userIDs,
+			AfterFollowedAt: arg.afterAt,
+			AfterID:         arg.afterID,
+			Limit:           arg.limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		edges := make(map[int64][]FollowEdge, len(userIDs))
+		for _, row := range rows {
+			edges[row.UserID] = append(edges[row.UserID], FollowEdge{User: row.User, FollowedAt: row.FollowedAt})
+		}
+		return edges, nil
+	})
+}
+
+// ListFollowingByKeys 批量分页列出关注的用户，供 DataLoader 使用
+func (s *Service) ListFollowingByKeys(ctx context.Context, keys []FollowPageKey) (map[FollowPageKey]*FollowPage, error) {
+	return batchFollowPages(keys, func(userIDs []int64, arg followPageArg) (map[int64][]FollowEdge, error) {
+		rows, err := s.userRepo.ListFollowingByUserIDs(ctx, userdb.ListFollowingByUserIDsParams{
+			UserIds:         userIDs,
+			AfterFollowedAt: arg.afterAt,
+			AfterID:         arg.afterID,
+			Limit:           arg.limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		edges := make(map[int64][]FollowEdge, len(userIDs))
+		for _, row := range rows {
+			edges[row.UserID] = append(edges[row.UserID], FollowEdge{User: row.User, FollowedAt: row.FollowedAt})
+		}
+		return edges, nil
+	})
+}
+
+// CountFollowers 批量统计粉丝数，供 DataLoader 使用
+func (s *Service) CountFollowers(ctx context.Context, userIDs []int64) (map[int64]int32, error) {
+	rows, err := s.userRepo.CountFollowersByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int32, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+// CountFollowing 批量统计关注数，供 DataLoader 使用
+func (s *Service) CountFollowing(ctx context.Context, userIDs []int64) (map[int64]int32, error) {
+	rows, err := s.userRepo.CountFollowingByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int32, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+// FollowedBy 批量判断 followerID 是否关注了 userIDs 中的每个用户
+func (s *Service) FollowedBy(ctx context.Context, followerID int64, userIDs []int64) (map[int64]bool, error) {
+	rows, err := s.userRepo.ListFollowPairs(ctx, []int64{followerID}, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		result[row.FolloweeID] = true
+	}
+	return result, nil
+}
+
+// Follows 批量判断 userIDs 中的每个用户是否关注了 followeeID
+func (s *Service) Follows(ctx context.Context, userIDs []int64, followeeID int64) (map[int64]bool, error) {
+	rows, err := s.userRepo.ListFollowPairs(ctx, userIDs, []int64{followeeID})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]bool, len(rows))
+	for _, row := range rows {
+		result[row.FollowerID] = true
+	}
+	return result, nil
+}
+
+func (s *Service) getFollowPair(ctx context.Context, uid string, followeeID int64) (*userdb.User, *userdb.User, error) {
+	follower, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if follower.ID == followeeID {
+		return nil, nil, ErrCannotFollowSelf
+	}
+	followee, err := s.userRepo.GetUserByID(ctx, followeeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return follower, followee, nil
+}
+
+// applyFollowCursor 规范化页大小并解析游标，写入查询参数，返回实际页大小
+func applyFollowCursor(first int32, after string, limit *int32, afterAt *pgtype.Timestamptz, afterID *pgtype.Int8) (int32, error) {
+	if first <= 0 {
+		first = defaultFollowPageSize
+	}
+	if first > maxFollowPageSize {
+		first = maxFollowPageSize
+	}
+	*limit = first + 1 // 多取一条判断是否还有下一页
+
+	if after != "" {
+		followedAt, id, err := decodeFollowCursor(after)
+		if err != nil {
+			return 0, err
+		}
+		*afterAt = pgtype.Timestamptz{Time: followedAt, Valid: true}
+		*afterID = pgtype.Int8{Int64: id, Valid: true}
+	}
+	return first, nil
+}
+
+// followPageArg 一组 key 共用的查询参数，limit 比页大小多一条
+type followPageArg struct {
+	limit   int32
+	afterAt pgtype.Timestamptz
+	afterID pgtype.Int8
+}
+
+// batchFollowPages 按 (First, After) 分组，每组调用一次 fetch 取组内全部用户的一页
+func batchFollowPages(keys []FollowPageKey, fetch func(userIDs []int64, arg followPageArg) (map[int64][]FollowEdge, error)) (map[FollowPageKey]*FollowPage, error) {
+	type group struct {
+		first int32
+		after string
+	}
+	groups := make(map[group][]int64)
+	for _, key := range keys {
+		g := group{first: key.First, after: key.After}
+		groups[g] = append(groups[g], key.UserID)
+	}
+
+	pages := make(map[FollowPageKey]*FollowPage, len(keys))
+	for g, userIDs := range groups {
+		var arg followPageArg
+		first, err := applyFollowCursor(g.first, g.after, &arg.limit, &arg.afterAt, &arg.afterID)
+		if err != nil {
+			return nil, err
+		}
+		edges, err := fetch(userIDs, arg)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range userIDs {
+			pages[FollowPageKey{UserID: id, First: g.first, After: g.after}] = buildFollowPage(edges[id], first)
+		}
+	}
+	return pages, nil
+}
+
+func buildFollowPage(edges []FollowEdge, first int32) *FollowPage {
+	page := &FollowPage{}
+	if int32(len(edges)) > first {
+		page.HasNextPage = true
+		edges = edges[:first]
+	}
+	for i := range edges {
+		edges[i].Cursor = encodeFollowCursor(edges[i].FollowedAt.Time, edges[i].User.ID)
+	}
+	page.Edges = edges
+	if len(edges) > 0 {
+		page.EndCursor = edges[len(edges)-1].Cursor
+	}
+	return page
+}
+
+func encodeFollowCursor(followedAt time.Time, userID int64) string {
+	raw := followedAt.UTC().Format(time.RFC3339Nano) + "|" + strconv.FormatInt(userID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeFollowCursor(cursor string) (time.Time, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	followedAtStr, idStr, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	followedAt, err := time.Parse(time.RFC3339Nano, followedAtStr)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, ErrInvalidCursor
+	}
+	return followedAt, id, nil
+}
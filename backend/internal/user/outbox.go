@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shiqi/datai/backend/internal/outbox"
+)
+
+// user_db 中的 outbox 消息类型
+const (
+	// OutboxNotification 给用户发送一条站内通知（写入 log_db）
+	OutboxNotification = "notification"
+)
+
+type notificationMessage struct {
+	UserID int64          `json:"userId"`
+	Kind   string         `json:"kind"`
+	Data   map[string]any `json:"data"`
+}
+
+// RegisterOutboxHandlers 注册 user_db outbox 的处理函数
+func (s *Service) RegisterOutboxHandlers(relay *outbox.Relay) {
+	relay.Handle(OutboxNotification, s.handleNotification)
+}
+
+// handleNotification 以 outbox 消息 ID 作为去重键，重复投递不会产生重复通知
+func (s *Service) handleNotification(ctx context.Context, msg outbox.Message) error {
+	var payload notificationMessage
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return err
+	}
+	return s.notifier.Notify(ctx, payload.UserID, payload.Kind, fmt.Sprintf("user_db.outbox:%d", msg.ID), payload.Data)
+}
@@ -0,0 +1,277 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	userdb "github.com/shiqi/datai/backend/db/user"
+)
+
+// 教育经历和工作经历，只能由本人增删改和排序；is_verified 只能由认证审核修改
+
+var (
+	ErrSchoolNameRequired  = errors.New("school name is required")
+	ErrCompanyNameRequired = errors.New("company name is required")
+	ErrEducationNotFound   = errors.New("education not found")
+	ErrEmploymentNotFound  = errors.New("employment not found")
+	ErrInvalidOrder        = errors.New("order must list each of your entries exactly once")
+)
+
+type EducationInput struct {
+	SchoolName string
+	Degree     string
+	Major      string
+}
+
+// UpdateEducationInput 中为 nil 的字段保持原值不变
+type UpdateEducationInput struct {
+	SchoolName *string
+	Degree     *string
+	Major      *string
+}
+
+type EmploymentInput struct {
+	CompanyName string
+	JobTitle    string
+}
+
+// UpdateEmploymentInput 中为 nil 的字段保持原值不变
+type UpdateEmploymentInput struct {
+	CompanyName *string
+	JobTitle    *string
+}
+
+func (s *Service) AddEducation(ctx context.Context, uid string, input EducationInput) (*userdb.UserEducation, error) {
+	schoolName := strings.TrimSpace(input.SchoolName)
+	if schoolName == "" {
+		return nil, ErrSchoolNameRequired
+	}
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return s.userRepo.CreateEducation(ctx, userdb.CreateEducationParams{
+		UserID:     u.ID,
+		SchoolName: schoolName,
+		Degree:     textOrNull(input.Degree),
+		Major:      textOrNull(input.Major),
+	})
+}
+
+// UpdateEducation 修改教育经历，内容变化后原有认证失效
+func (s *Service) UpdateEducation(ctx context.Context, uid string, educationID int64, input UpdateEducationInput) (*userdb.UserEducation, error) {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.userRepo.GetEducationByID(ctx, educationID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && existing.UserID != u.ID) {
+		return nil, ErrEducationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	arg := userdb.UpdateEducationParams{
+		ID:         existing.ID,
+		UserID:     u.ID,
+		SchoolName: existing.SchoolName,
+		Degree:     existing.Degree,
+		Major:      existing.Major,
+	}
+	if input.SchoolName != nil {
+		schoolName := strings.TrimSpace(*input.SchoolName)
+		if schoolName == "" {
+			return nil, ErrSchoolNameRequired
+		}
+		arg.SchoolName = schoolName
+	}
+	if input.Degree != nil {
+		arg.Degree = textOrNull(*input.Degree)
+	}
+	if input.Major != nil {
+		arg.Major = textOrNull(*input.Major)
+	}
+
+	result, err := s.userRepo.UpdateEducation(ctx, arg)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrEducationNotFound
+	}
+	return result, err
+}
+
+func (s *Service) DeleteEducation(ctx context.Context, uid string, educationID int64) error {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return err
+	}
+	n, err := s.userRepo.DeleteEducation(ctx, educationID, u.ID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrEducationNotFound
+	}
+	return nil
+}
+
+// ReorderEducations 按 ids 的顺序重排，ids 必须恰好是本人的全部教育经历
+func (s *Service) ReorderEducations(ctx context.Context, uid string, ids []int64) ([]userdb.UserEducation, error) {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.userRepo.ListEducationsByUserIDs(ctx, []int64{u.ID})
+	if err != nil {
+		return nil, err
+	}
+	current := make([]int64, 0, len(existing))
+	for _, e := range existing {
+		current = append(current, e.ID)
+	}
+	if !sameIDSet(current, ids) {
+		return nil, ErrInvalidOrder
+	}
+	if err := s.userRepo.ReorderEducations(ctx, u.ID, ids); err != nil {
+		return nil, err
+	}
+	return s.userRepo.ListEducationsByUserIDs(ctx, []int64{u.ID})
+}
+
+// ListEducations 批量读取多个用户的教育经历，供 DataLoader 使用
+func (s *Service) ListEducations(ctx context.Context, userIDs []int64) (map[int64][]userdb.UserEducation, error) {
+	rows, err := s.userRepo.ListEducationsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64][]userdb.UserEducation, len(userIDs))
+	for _, row := range rows {
+		result[row.UserID] = append(result[row.UserID], row)
+	}
+	return result, nil
+}
+
+func (s *Service) AddEmployment(ctx context.Context, uid string, input EmploymentInput) (*userdb.UserEmployment, error) {
+	companyName := strings.TrimSpace(input.CompanyName)
+	if companyName == "" {
+		return nil, ErrCompanyNameRequired
+	}
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return s.userRepo.CreateEmployment(ctx, userdb.CreateEmploymentParams{
+		UserID:      u.ID,
+		CompanyName: companyName,
+		JobTitle:    textOrNull(input.JobTitle),
+	})
+}
+
+// UpdateEmployment 修改工作经历，内容变化后原有认证失效
+func (s *Service) UpdateEmployment(ctx context.Context, uid string, employmentID int64, input UpdateEmploymentInput) (*userdb.UserEmployment, error) {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.userRepo.GetEmploymentByID(ctx, employmentID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && existing.UserID != u.ID) {
+		return nil, ErrEmploymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	arg := userdb.UpdateEmploymentParams{
+		ID:          existing.ID,
+		UserID:      u.ID,
+		CompanyName: existing.CompanyName,
+		JobTitle:    existing.JobTitle,
+	}
+	if input.CompanyName != nil {
+		companyName := strings.TrimSpace(*input.CompanyName)
+		if companyName == "" {
+			return nil, ErrCompanyNameRequired
+		}
+		arg.CompanyName = companyName
+	}
+	if input.JobTitle != nil {
+		arg.JobTitle = textOrNull(*input.JobTitle)
+	}
+
+	result, err := s.userRepo.UpdateEmployment(ctx, arg)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrEmploymentNotFound
+	}
+	return result, err
+}
+
+func (s *Service) DeleteEmployment(ctx context.Context, uid string, employmentID int64) error {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return err
+	}
+	n, err := s.userRepo.DeleteEmployment(ctx, employmentID, u.ID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrEmploymentNotFound
+	}
+	return nil
+}
+
+// ReorderEmployments 按 ids 的顺序重排，ids 必须恰好是本人的全部工作经历
+func (s *Service) ReorderEmployments(ctx context.Context, uid string, ids []int64) ([]userdb.UserEmployment, error) {
+	u, err := s.userRepo.GetUserByUID(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.userRepo.ListEmploymentsByUserIDs(ctx, []int64{u.ID})
+	if err != nil {
+		return nil, err
+	}
+	current := make([]int64, 0, len(existing))
+	for _, e := range existing {
+		current = append(current, e.ID)
+	}
+	if !sameIDSet(current, ids) {
+		return nil, ErrInvalidOrder
+	}
+	if err := s.userRepo.ReorderEmployments(ctx, u.ID, ids); err != nil {
+		return nil, err
+	}
+	return s.userRepo.ListEmploymentsByUserIDs(ctx, []int64{u.ID})
+}
+
+// ListEmployments 批量读取多个用户的工作经历，供 DataLoader 使用
+func (s *Service) ListEmployments(ctx context.Context, userIDs []int64) (map[int64][]userdb.UserEmployment, error) {
+	rows, err := s.userRepo.ListEmploymentsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64][]userdb.UserEmployment, len(userIDs))
+	for _, row := range rows {
+		result[row.UserID] = append(result[row.UserID], row)
+	}
+	return result, nil
+}
+
+// sameIDSet 判断两组 ID 是否恰好相同且 b 中没有重复
+func sameIDSet(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := slices.Clone(a)
+	sortedB := slices.Clone(b)
+	slices.Sort(sortedA)
+	slices.Sort(sortedB)
+	return slices.Equal(sortedA, sortedB)
+}
+
+func textOrNull(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
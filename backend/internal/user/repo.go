@@ -2,13 +2,20 @@ package user
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	userdb "github.com/shiqi/datai/backend/db/user" // sqlc 生成的包
+	"github.com/shiqi/datai/backend/internal/outbox"
 )
 
 type Repository struct {
-	q *userdb.Queries
+	q    *userdb.Queries
+	pool *pgxpool.Pool
 }
 
 type User struct {
@@ -19,8 +26,15 @@ type User struct {
 	CreatedAt time.Time
 }
 
-func NewRepository(q *userdb.Queries) *Repository {
-	return &Repository{q}
+func NewRepository(q *userdb.Queries, pool *pgxpool.Pool) *Repository {
+	return &Repository{q: q, pool: pool}
+}
+
+// WithTx 在同一个事务中执行 fn，fn 返回错误时回滚
+func (r *Repository) WithTx(ctx context.Context, fn func(txRepo *Repository) error) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return fn(&Repository{q: r.q.WithTx(tx), pool: r.pool})
+	})
 }
 
 func (r *Repository) GetUserByUID(ctx context.Context, uid string) (*userdb.User, error) {
@@ -37,3 +51,299 @@ func (r *Repository) UpsertUser(ctx context.Context, u *userdb.User) error {
 		Nickname: u.Nickname,
 	})
 }
+
+func (r *Repository) GetUserByID(ctx context.Context, id int64) (*userdb.User, error) {
+	user, err := r.q.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *Repository) UpdateUserRating(ctx context.Context, id int64, avg pgtype.Numeric, count int32) error {
+	return r.q.UpdateUserRating(ctx, userdb.UpdateUserRatingParams{
+		ID:          id,
+		RatingAvg:   avg,
+		RatingCount: pgtype.Int4{Int32: count, Valid: true},
+	})
+}
+
+func (r *Repository) FollowUser(ctx context.Context, followerID, followeeID int64) error {
+	return r.q.FollowUser(ctx, userdb.FollowUserParams{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+}
+
+func (r *Repository) UnfollowUser(ctx context.Context, followerID, followeeID int64) error {
+	return r.q.UnfollowUser(ctx, userdb.UnfollowUserParams{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+}
+
+func (r *Repository) ListFollowersByUserIDs(ctx context.Context, arg userdb.ListFollowersByUserIDsParams) ([]userdb.ListFollowersByUserIDsRow, error) {
+	return r.q.ListFollowersByUserIDs(ctx, arg)
+}
+
+func (r *Repository) ListFollowingByUserIDs(ctx context.Context, arg userdb.ListFollowingByUserIDsParams) ([]userdb.ListFollowingByUserIDsRow, error) {
+	return r.q.ListFollowingByUserIDs(ctx, arg)
+}
+
+func (r *Repository) CountFollowersByUserIDs(ctx context.Context, userIDs []int64) ([]userdb.CountFollowersByUserIDsRow, error) {
+	return r.q.CountFollowersByUserIDs(ctx, userIDs)
+}
+
+func (r *Repository) CountFollowingByUserIDs(ctx context.Context, userIDs []int64) ([]userdb.CountFollowingByUserIDsRow, error) {
+	return r.q.CountFollowingByUserIDs(ctx, userIDs)
+}
+
+func (r *Repository) ListFollowPairs(ctx context.Context, followerIDs, followeeIDs []int64) ([]userdb.ListFollowPairsRow, error) {
+	return r.q.ListFollowPairs(ctx, userdb.ListFollowPairsParams{
+		FollowerIds: followerIDs,
+		FolloweeIds: followeeIDs,
+	})
+}
+
+func (r *Repository) GetEducationByID(ctx context.Context, id int64) (*userdb.UserEducation, error) {
+	e, err := r.q.GetEducationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *Repository) ListEducationsByUserIDs(ctx context.Context, userIDs []int64) ([]userdb.UserEducation, error) {
+	return r.q.ListEducationsByUserIDs(ctx, userIDs)
+}
+
+func (r *Repository) CreateEducation(ctx context.Context, arg userdb.CreateEducationParams) (*userdb.UserEducation, error) {
+	e, err := r.q.CreateEducation(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *Repository) UpdateEducation(ctx context.Context, arg userdb.UpdateEducationParams) (*userdb.UserEducation, error) {
+	e, err := r.q.UpdateEducation(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *Repository) DeleteEducation(ctx context.Context, id, userID int64) (int64, error) {
+	return r.q.DeleteEducation(ctx, userdb.DeleteEducationParams{ID: id, UserID: userID})
+}
+
+func (r *Repository) ReorderEducations(ctx context.Context, userID int64, ids []int64) error {
+	return r.q.ReorderEducations(ctx, userdb.ReorderEducationsParams{Ids: ids, UserID: userID})
+}
+
+func (r *Repository) GetEmploymentByID(ctx context.Context, id int64) (*userdb.UserEmployment, error) {
+	e, err := r.q.GetEmploymentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *Repository) ListEmploymentsByUserIDs(ctx context.Context, userIDs []int64) ([]userdb.UserEmployment, error) {
+	return r.q.ListEmploymentsByUserIDs(ctx, userIDs)
+}
+
+func (r *Repository) CreateEmployment(ctx context.Context, arg userdb.CreateEmploymentParams) (*userdb.UserEmployment, error) {
+	e, err := r.q.CreateEmployment(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *Repository) UpdateEmployment(ctx context.Context, arg userdb.UpdateEmploymentParams) (*userdb.UserEmployment, error) {
+	e, err := r.q.UpdateEmployment(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *Repository) DeleteEmployment(ctx context.Context, id, userID int64) (int64, error) {
+	return r.q.DeleteEmployment(ctx, userdb.DeleteEmploymentParams{ID: id, UserID: userID})
+}
+
+func (r *Repository) ReorderEmployments(ctx context.Context, userID int64, ids []int64) error {
+	return r.q.ReorderEmployments(ctx, userdb.ReorderEmploymentsParams{Ids: ids, UserID: userID})
+}
+
+func (r *Repository) CreateCertification(ctx context.Context, arg userdb.CreateCertificationParams) (*userdb.UserCertification, error) {
+	c, err := r.q.CreateCertification(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *Repository) GetCertificationForUpdate(ctx context.Context, id int64) (*userdb.UserCertification, error) {
+	c, err := r.q.GetCertificationForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *Repository) CountPendingCertificationsForEntry(ctx context.Context, arg userdb.CountPendingCertificationsForEntryParams) (int64, error) {
+	return r.q.CountPendingCertificationsForEntry(ctx, arg)
+}
+
+func (r *Repository) ListPendingCertifications(ctx context.Context, arg userdb.ListPendingCertificationsParams) ([]userdb.UserCertification, error) {
+	return r.q.ListPendingCertifications(ctx, arg)
+}
+
+func (r *Repository) ListUserCertifications(ctx context.Context, userID int64) ([]userdb.UserCertification, error) {
+	return r.q.ListUserCertifications(ctx, userID)
+}
+
+func (r *Repository) ReviewCertification(ctx context.Context, arg userdb.ReviewCertificationParams) (*userdb.UserCertification, error) {
+	c, err := r.q.ReviewCertification(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *Repository) SetEducationVerified(ctx context.Context, id, userID int64) error {
+	return r.q.SetEducationVerified(ctx, userdb.SetEducationVerifiedParams{ID: id, UserID: userID})
+}
+
+func (r *Repository) SetEmploymentVerified(ctx context.Context, id, userID int64) error {
+	return r.q.SetEmploymentVerified(ctx, userdb.SetEmploymentVerifiedParams{ID: id, UserID: userID})
+}
+
+func (r *Repository) CreateAPIKey(ctx context.Context, arg userdb.CreateAPIKeyParams) (*userdb.ApiKey, error) {
+	k, err := r.q.CreateAPIKey(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+func (r *Repository) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*userdb.ApiKey, error) {
+	k, err := r.q.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+func (r *Repository) ListAPIKeysByUser(ctx context.Context, userID int64) ([]userdb.ApiKey, error) {
+	return r.q.ListAPIKeysByUser(ctx, userID)
+}
+
+func (r *Repository) CountActiveAPIKeys(ctx context.Context, userID int64) (int64, error) {
+	return r.q.CountActiveAPIKeys(ctx, userID)
+}
+
+func (r *Repository) RevokeAPIKey(ctx context.Context, id, userID int64) (*userdb.ApiKey, error) {
+	k, err := r.q.RevokeAPIKey(ctx, userdb.RevokeAPIKeyParams{ID: id, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	return &k, nil
+}
+
+func (r *Repository) TouchAPIKey(ctx context.Context, id int64) error {
+	return r.q.TouchAPIKey(ctx, id)
+}
+
+func (r *Repository) TouchSession(ctx context.Context, arg userdb.TouchSessionParams) (*userdb.Session, error) {
+	sess, err := r.q.TouchSession(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &sess, nil
+}
+
+func (r *Repository) ListActiveSessionsByUser(ctx context.Context, uid string) ([]userdb.Session, error) {
+	return r.q.ListActiveSessionsByUser(ctx, uid)
+}
+
+func (r *Repository) RevokeSession(ctx context.Context, id int64, uid string) (*userdb.Session, error) {
+	sess, err := r.q.RevokeSession(ctx, userdb.RevokeSessionParams{ID: id, UserUid: uid})
+	if err != nil {
+		return nil, err
+	}
+	return &sess, nil
+}
+
+func (r *Repository) RevokeSessionsByUser(ctx context.Context, uid string) (int64, error) {
+	return r.q.RevokeSessionsByUser(ctx, uid)
+}
+
+func (r *Repository) RevokeSessionByKey(ctx context.Context, issuer, key, uid string) error {
+	return r.q.RevokeSessionByKey(ctx, userdb.RevokeSessionByKeyParams{Issuer: issuer, SessionKey: key, UserUid: uid})
+}
+
+func (r *Repository) GetSessionByKey(ctx context.Context, issuer, key string) (*userdb.Session, error) {
+	sess, err := r.q.GetSessionByKey(ctx, userdb.GetSessionByKeyParams{Issuer: issuer, SessionKey: key})
+	if err != nil {
+		return nil, err
+	}
+	return &sess, nil
+}
+
+// GetSessionCutoff 用户最近一次退出全部会话的时间，没有时 Valid 为 false
+func (r *Repository) GetSessionCutoff(ctx context.Context, uid string) (pgtype.Timestamptz, error) {
+	cutoff, err := r.q.GetSessionCutoff(ctx, uid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return pgtype.Timestamptz{}, nil
+	}
+	return cutoff, err
+}
+
+func (r *Repository) SetSessionCutoff(ctx context.Context, uid string) error {
+	return r.q.SetSessionCutoff(ctx, uid)
+}
+
+func (r *Repository) DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error) {
+	return r.q.DeleteExpiredSessions(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+}
+
+// EnqueueOutbox 写入一条 outbox 消息，需要在业务写入的事务中调用（txRepo）
+func (r *Repository) EnqueueOutbox(ctx context.Context, kind string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return r.q.EnqueueOutbox(ctx, userdb.EnqueueOutboxParams{Kind: kind, Payload: data})
+}
+
+// ClaimOutbox 实现 outbox.Store
+func (r *Repository) ClaimOutbox(ctx context.Context, batchSize, maxAttempts int32, lease time.Duration) ([]outbox.Message, error) {
+	rows, err := r.q.ClaimOutbox(ctx, userdb.ClaimOutboxParams{
+		LeaseSeconds: int32(lease / time.Second),
+		MaxAttempts:  maxAttempts,
+		BatchSize:    batchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]outbox.Message, 0, len(rows))
+	for _, row := range rows {
+		msgs = append(msgs, outbox.Message{ID: row.ID, Kind: row.Kind, Payload: row.Payload, Attempts: row.Attempts})
+	}
+	return msgs, nil
+}
+
+func (r *Repository) CompleteOutbox(ctx context.Context, id int64) error {
+	return r.q.CompleteOutbox(ctx, id)
+}
+
+func (r *Repository) RetryOutbox(ctx context.Context, id int64, lastErr string, delay time.Duration) error {
+	return r.q.RetryOutbox(ctx, userdb.RetryOutboxParams{
+		ID:           id,
+		LastError:    pgtype.Text{String: lastErr, Valid: true},
+		DelaySeconds: int32(delay / time.Second),
+	})
+}
@@ -9,10 +9,17 @@ import (
 
 type Service struct {
 	userRepo *Repository
+	notifier Notifier
+
+	sessionsRevoked func(uid string) // 由 OnSessionsRevoked 注册
 }
 
-func NewService(userRepo *Repository) *Service {
-	return &Service{userRepo: userRepo}
+// NewService notifier 为 nil 时不发送通知
+func NewService(userRepo *Repository, notifier Notifier) *Service {
+	if notifier == nil {
+		notifier = NopNotifier{}
+	}
+	return &Service{userRepo: userRepo, notifier: notifier}
 }
 
 type UpsertUserInput struct {
@@ -48,3 +55,12 @@ func (s *Service) UpsertUser(ctx context.Context, input UpsertUserInput) (*userd
 func (s *Service) GetUserByUID(ctx context.Context, uid string) (*userdb.User, error) {
 	return s.userRepo.GetUserByUID(ctx, uid)
 }
+
+func (s *Service) GetUserByID(ctx context.Context, id int64) (*userdb.User, error) {
+	return s.userRepo.GetUserByID(ctx, id)
+}
+
+// UpdateRatingStats 写入由 user_ratings 重新计算出的评分汇总
+func (s *Service) UpdateRatingStats(ctx context.Context, userID int64, avg pgtype.Numeric, count int32) error {
+	return s.userRepo.UpdateUserRating(ctx, userID, avg, count)
+}
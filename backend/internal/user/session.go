@@ -0,0 +1,151 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	userdb "github.com/shiqi/datai/backend/db/user"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// 会话登记：认证中间件通过 CheckSession 登记每个 JWT 会话并检查是否已撤销，
+// 用户可以查看和撤销自己的会话，Authing 的 back-channel logout 也会撤销会话
+
+const (
+	sessionCleanupInterval = time.Hour
+	// 过期的会话保留一段时间，便于用户查看最近的登录记录
+	sessionRetention = 7 * 24 * time.Hour
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// OnSessionsRevoked 注册撤销会话后的回调，用于清除认证中间件的缓存
+func (s *Service) OnSessionsRevoked(fn func(uid string)) {
+	s.sessionsRevoked = fn
+}
+
+// CheckSession 实现 middleware.SessionRegistry：登记会话并检查是否已撤销
+func (s *Service) CheckSession(ctx context.Context, sess *middleware.Session) (bool, error) {
+	cutoff, err := s.userRepo.GetSessionCutoff(ctx, sess.UserID)
+	if err != nil {
+		return false, err
+	}
+	// iat 精确到秒，同一秒内签发的 token 不受影响；没有 iat 的 token 无法判断，一律视为已撤销
+	if cutoff.Valid && (sess.IssuedAt.IsZero() || sess.IssuedAt.Before(cutoff.Time.Truncate(time.Second))) {
+		return true, nil
+	}
+	if sess.ID == "" {
+		return false, nil
+	}
+
+	arg := userdb.TouchSessionParams{
+		Issuer:     sess.Issuer,
+		SessionKey: sess.ID,
+		UserUid:    sess.UserID,
+		UserAgent:  pgtype.Text{String: sess.UserAgent, Valid: sess.UserAgent != ""},
+		IpAddress:  pgtype.Text{String: sess.IPAddress, Valid: sess.IPAddress != ""},
+	}
+	if !sess.ExpiresAt.IsZero() {
+		arg.ExpiresAt = pgtype.Timestamptz{Time: sess.ExpiresAt, Valid: true}
+	}
+	row, err := s.userRepo.TouchSession(ctx, arg)
+	if err != nil {
+		return false, err
+	}
+	// 同一个 sid 属于其他用户说明 token 有问题，按已撤销处理
+	return row.RevokedAt.Valid || row.UserUid != sess.UserID, nil
+}
+
+// ListSessions 用户未撤销、未过期的会话，最近访问的在前
+func (s *Service) ListSessions(ctx context.Context, uid string) ([]userdb.Session, error) {
+	return s.userRepo.ListActiveSessionsByUser(ctx, uid)
+}
+
+// RevokeSession 撤销本人的会话，该会话的 token 随即失效
+func (s *Service) RevokeSession(ctx context.Context, uid string, id int64) (*userdb.Session, error) {
+	revoked, err := s.userRepo.RevokeSession(ctx, id, uid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// 不存在、不属于本人或已撤销
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.notifySessionsRevoked(uid)
+	return revoked, nil
+}
+
+// RevokeAllSessions 撤销用户的全部会话，包括尚未登记的会话和无法单独撤销的 token，返回撤销的已登记会话数
+func (s *Service) RevokeAllSessions(ctx context.Context, uid string) (int64, error) {
+	var n int64
+	err := s.userRepo.WithTx(ctx, func(txRepo *Repository) error {
+		if err := txRepo.SetSessionCutoff(ctx, uid); err != nil {
+			return err
+		}
+		var err error
+		n, err = txRepo.RevokeSessionsByUser(ctx, uid)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	s.notifySessionsRevoked(uid)
+	return n, nil
+}
+
+// LogoutSession 实现 middleware.SessionRegistry；会话尚未登记时按 uid 登记为已撤销，uid 也未知时忽略
+func (s *Service) LogoutSession(ctx context.Context, issuer, sessionID, uid string) error {
+	if uid == "" {
+		sess, err := s.userRepo.GetSessionByKey(ctx, issuer, sessionID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		uid = sess.UserUid
+	}
+	if err := s.userRepo.RevokeSessionByKey(ctx, issuer, sessionID, uid); err != nil {
+		return err
+	}
+	s.notifySessionsRevoked(uid)
+	return nil
+}
+
+// LogoutUser 实现 middleware.SessionRegistry
+func (s *Service) LogoutUser(ctx context.Context, uid string) error {
+	_, err := s.RevokeAllSessions(ctx, uid)
+	return err
+}
+
+// RunSessionCleanup 定期删除过期较久的会话，直到 ctx 被取消
+func (s *Service) RunSessionCleanup(ctx context.Context) {
+	ticker := time.NewTicker(sessionCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		n, err := s.userRepo.DeleteExpiredSessions(ctx, time.Now().Add(-sessionRetention))
+		switch {
+		case err != nil && ctx.Err() == nil:
+			slog.Error("failed to delete expired sessions", "error", err)
+		case n > 0:
+			slog.Info("deleted expired sessions", "count", n)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) notifySessionsRevoked(uid string) {
+	if s.sessionsRevoked != nil {
+		s.sessionsRevoked(uid)
+	}
+}
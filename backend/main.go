@@ -2,122 +2,313 @@ package main
 
 import (
 	"context"
-	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
-	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
+	eventdb "github.com/shiqi/datai/backend/db/events"
+	logdb "github.com/shiqi/datai/backend/db/log"
+	tenantdb "github.com/shiqi/datai/backend/db/tenant"
 	userdb "github.com/shiqi/datai/backend/db/user"
 	gqlgenerated "github.com/shiqi/datai/backend/gql/generated"
 	"github.com/shiqi/datai/backend/gql/resolver"
+	"github.com/shiqi/datai/backend/internal/bus"
+	"github.com/shiqi/datai/backend/internal/config"
+	eventpkg "github.com/shiqi/datai/backend/internal/event"
+	"github.com/shiqi/datai/backend/internal/health"
 	"github.com/shiqi/datai/backend/internal/middleware"
+	"github.com/shiqi/datai/backend/internal/migration"
+	notificationpkg "github.com/shiqi/datai/backend/internal/notification"
+	"github.com/shiqi/datai/backend/internal/outbox"
+	tenantpkg "github.com/shiqi/datai/backend/internal/tenant"
 	userpkg "github.com/shiqi/datai/backend/internal/user"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 // 加载 .env 文件，找不到也不报错（用于生产环境用 shell 注入）
 func loadEnv() {
 	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found. Using default environment vars.")
+		slog.Info("no .env file found, using environment variables")
 	}
 }
 
-func getEnv(key, fallback string) string {
-	if val := os.Getenv(key); val != "" {
-		return val
+// newLogger 按配置创建 slog logger；设为默认后标准库 log 的输出也经过它
+func newLogger(cfg config.LogConfig) *slog.Logger {
+	level, _ := cfg.SlogLevel() // 已由 Validate 校验
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: middleware.RedactAttr}
+	if cfg.Format == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
 	}
-	return fallback
+	return slog.New(slog.NewTextHandler(os.Stderr, opts))
 }
 
-func waitForDB(dsn string) {
-	for {
-		db, err := sql.Open("postgres", dsn)
-		if err == nil {
-			if err = db.Ping(); err == nil {
-				log.Println("Connected to DB:", dsn)
-				db.Close()
-				return
-			}
-		}
-		log.Println("Waiting for DB to be ready:", dsn)
-		time.Sleep(2 * time.Second)
-	}
-}
-
-func runMigration(name, path, dsn string) {
-	log.Printf("🛠️  Migrating %s...", name)
-	m, err := migrate.New(path, dsn)
-	if err != nil {
-		log.Fatalf("❌ Migration init failed for %s: %v", name, err)
-	}
-	err = m.Up()
-	if err != nil && err.Error() != "no change" {
-		log.Fatalf("❌ Migration failed for %s: %v", name, err)
-	}
-	log.Printf("✅ Migration complete for %s", name)
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
 }
 
 func main() {
 	loadEnv()
 
-	// 数据库
-	dbUser := getEnv("DB_USER", "dataiuser")
-	pass := getEnv("DB_PASSWORD", "dataipass")
-	host := getEnv("DB_HOST", "localhost")
-	dbPort := getEnv("DB_PORT", "5432")
+	cfg, args, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	// datai migrate ... 单独管理迁移，不启动服务；datai config 输出生效的配置；datai dev-token 签发本地开发 token
+	if len(args) > 0 {
+		switch args[0] {
+		case "migrate":
+			os.Exit(runMigrateCommand(cfg, args[1:]))
+		case "config":
+			fmt.Print(cfg.Redacted())
+			return
+		case "dev-token":
+			os.Exit(runDevTokenCommand(cfg, args[1:]))
+		default:
+			fmt.Fprintf(os.Stderr, "unknown command %q, available: migrate, config, dev-token\n", args[0])
+			os.Exit(2)
+		}
+	}
+	slog.SetDefault(newLogger(cfg.Log))
+	slog.Debug("effective config", "config", cfg.Redacted())
 
-	dbs := []string{"user_db", "events_db", "tenant_db"}
+	// SIGINT / SIGTERM 触发优雅退出
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	for _, db := range dbs {
-		dsn := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", dbUser, pass, host, dbPort, db)
-		waitForDB(dsn)
-		runMigration(db, fmt.Sprintf("file://migrations/%s", db[0:len(db)-3]), dsn) // "user_db" -> "user"
+	for _, db := range migrationDatabases(cfg) {
+		if err := waitForDB(ctx, db.DSN, cfg.Database.WaitTimeout); err != nil {
+			fatal("database not ready", "db", db.Name, "error", err)
+		}
+		if !cfg.Server.AutoMigrate {
+			continue
+		}
+		slog.Info("applying migrations", "db", db.Name)
+		if err := migration.Up(db); err != nil {
+			fatal("migration failed", "db", db.Name, "error", err)
+		}
+		slog.Info("migrations applied", "db", db.Name)
 	}
 
 	// 设置依赖注入
 	// 连接用户数据库
-	userDSN := fmt.Sprintf("postgres://%s:%s@%s:%s/user_db?sslmode=disable", dbUser, pass, host, dbPort)
+	userDSN := cfg.Database.DSN("user")
 	userPool, err := pgxpool.New(context.Background(), userDSN)
 	if err != nil {
-		log.Fatalf("Failed to connect to user database: %v", err)
+		fatal("failed to connect to database", "db", "user", "error", err)
 	}
 	defer userPool.Close()
 
+	// 连接活动数据库
+	eventsDSN := cfg.Database.DSN("events")
+	eventsPool, err := pgxpool.New(context.Background(), eventsDSN)
+	if err != nil {
+		fatal("failed to connect to database", "db", "events", "error", err)
+	}
+	defer eventsPool.Close()
+
+	// 连接租户数据库
+	tenantDSN := cfg.Database.DSN("tenant")
+	tenantPool, err := pgxpool.New(context.Background(), tenantDSN)
+	if err != nil {
+		fatal("failed to connect to database", "db", "tenant", "error", err)
+	}
+	defer tenantPool.Close()
+
+	// 连接日志数据库（通知等）
+	logDSN := cfg.Database.DSN("log")
+	logPool, err := pgxpool.New(context.Background(), logDSN)
+	if err != nil {
+		fatal("failed to connect to database", "db", "log", "error", err)
+	}
+	defer logPool.Close()
+
 	// 创建Repository （依赖数据库连接）
 	userQueries := userdb.New(userPool)
-	userRepo := userpkg.NewRepository(userQueries)
+	userRepo := userpkg.NewRepository(userQueries, userPool)
+	eventQueries := eventdb.New(eventsPool)
+	eventRepo := eventpkg.NewRepository(eventQueries, eventsPool)
+	tenantQueries := tenantdb.New(tenantPool)
+	tenantRepo := tenantpkg.NewRepository(tenantQueries, tenantPool)
+	logQueries := logdb.New(logPool)
+	notificationRepo := notificationpkg.NewRepository(logQueries, logPool)
+
+	// 后台任务（总线监听、outbox relay）在 HTTP 服务退出后停止，之后才关闭连接池
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	runWorker := func(run func(context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
+	// 事件总线，供 GraphQL subscription 推送；多实例部署时用 postgres 跨实例分发
+	var eventBus bus.Bus
+	if cfg.Bus.Driver == "postgres" {
+		pgBus := bus.NewPostgres(eventsPool, cfg.Bus.Channel)
+		runWorker(pgBus.Run)
+		eventBus = pgBus
+	} else {
+		eventBus = bus.NewMemory()
+	}
 
 	// 创建Service
-	userService := userpkg.NewService(userRepo)
+	notificationService := notificationpkg.NewService(notificationRepo, eventBus)
+	userService := userpkg.NewService(userRepo, notificationService)
+	eventService := eventpkg.NewService(eventRepo, userService, eventpkg.Options{
+		CommentMaxDepth: cfg.Event.CommentMaxDepth,
+		Bus:             eventBus,
+	})
+	tenantService := tenantpkg.NewService(tenantRepo, userService)
+
+	// outbox relay：把各库 outbox 中的跨库副作用投递出去
+	eventsRelay := outbox.NewRelay("events_db", eventRepo, outbox.Options{})
+	eventService.RegisterOutboxHandlers(eventsRelay)
+	runWorker(eventsRelay.Run)
+	userRelay := outbox.NewRelay("user_db", userRepo, outbox.Options{})
+	userService.RegisterOutboxHandlers(userRelay)
+	runWorker(userRelay.Run)
 
 	// 创建Resolver
 	resolver := &resolver.Resolver{
-		UserService: userService,
+		UserService:         userService,
+		EventService:        eventService,
+		TenantService:       tenantService,
+		NotificationService: notificationService,
 	}
 
 	// Authing 中间件
-	port := getEnv("PORT", "8080")
-	authMiddleware := middleware.NewAuthingMiddleware(
-		getEnv("AUTHING_JWKS_URL", "https://qiyu-datai.authing.cn/oidc/.well-known/jwks.json"), // JWKS URL
-		getEnv("AUTHING_AUDIENCE", ""),                                 // Audience (暂时留空)
-		getEnv("AUTHING_ISSUER", "https://qiyu-datai.authing.cn/oidc"), // Issuer (Authing 根域名，不带 /oidc)
-		getEnv("AUTHING_SECRET", ""),                                   // Client Secret
-	)
+	var issuers []middleware.IssuerConfig
+	for _, iss := range cfg.Auth.TrustedIssuers() {
+		issuers = append(issuers, middleware.IssuerConfig(iss))
+	}
+	// 本地开发认证：本服务提供 JWKS，信任 datai dev-token 签发的 token
+	var devAuth *middleware.DevAuth
+	if cfg.Auth.Dev.Enabled {
+		if devAuth, err = middleware.LoadDevAuth(cfg.Auth.Dev.KeyFile, cfg.Auth.Dev.Issuer); err != nil {
+			fatal("failed to load dev auth key", "error", err)
+		}
+		// 本服务在 DevJWKSPath 提供 JWKS；服务在容器中或绑定到其他地址时由 auth.dev.jwks_url 指定可访问的地址
+		jwksURL := cfg.Auth.Dev.JWKSURL
+		if jwksURL == "" {
+			jwksURL = fmt.Sprintf("http://localhost:%d%s", cfg.Server.Port, middleware.DevJWKSPath)
+		}
+		issuers = append(issuers, devAuth.IssuerConfig(jwksURL))
+		slog.Warn("dev auth enabled, tokens from `datai dev-token` are trusted; never enable it in production", "issuer", devAuth.Issuer)
+	}
+	authMiddleware, err := middleware.NewAuthingMiddleware(issuers...)
+	if err != nil {
+		fatal("invalid auth config", "error", err)
+	}
+	authMiddleware.ClockSkew = cfg.Auth.ClockSkew
+	// 服务端脚本可以用 API key（Bearer dtk_...）代替 JWT
+	authMiddleware.APIKeys = userService
+	// 登记 JWT 会话，拒绝已撤销会话的 token；本进程撤销会话时立即清除缓存
+	authMiddleware.Sessions = userService
+	authMiddleware.SessionCacheTTL = cfg.Auth.SessionCacheTTL
+	userService.OnSessionsRevoked(authMiddleware.ForgetSessions)
+	runWorker(userService.RunSessionCleanup)
+
+	// 开启 auth.optional 时允许匿名浏览公开活动，需要登录的字段由 @auth 等指令控制；默认关闭
+	authMiddleware.Optional = cfg.Auth.Optional
+	// 在过期前后台刷新 JWKS 公钥
+	runWorker(authMiddleware.Run)
+
+	tenantMiddleware := middleware.NewTenantMiddleware(tenantService)
 
 	// Authing 构建 GraphQL 服务器
-	srv := handler.NewDefaultServer(gqlgenerated.NewExecutableSchema(gqlgenerated.Config{Resolvers: resolver}))
+	srv := handler.New(gqlgenerated.NewExecutableSchema(gqlgenerated.Config{
+		Resolvers:  resolver,
+		Directives: resolver.Directives(),
+	}))
+	// 与 handler.NewDefaultServer 相同的配置，WebSocket 在 connection_init 时认证
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              middleware.WebSocketInit(authMiddleware, tenantMiddleware),
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	// 把 GraphQL 操作名写入请求日志
+	srv.AroundOperations(middleware.LogOperation)
+	// API key 按 scope 限制只读或可写
+	srv.AroundOperations(middleware.APIKeyScopes)
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
+
+	// 健康检查：/healthz 存活探针，/readyz 检查各数据库连通性和迁移版本
+	var healthDBs []health.Database
+	pools := map[string]*pgxpool.Pool{"user": userPool, "events": eventsPool, "tenant": tenantPool, "log": logPool}
+	for _, db := range migrationDatabases(cfg) {
+		latest, err := migration.Latest(db.Dir)
+		if err != nil {
+			fatal("failed to read migrations", "db", db.Name, "error", err)
+		}
+		healthDBs = append(healthDBs, health.Database{Name: db.Name, Pool: pools[db.Name], Latest: latest})
+	}
+	checker := health.NewChecker(healthDBs)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", checker.Liveness)
+	mux.HandleFunc("/readyz", checker.Readiness)
+	mux.Handle("/", playground.Handler("GraphQL", "/query"))
+	if devAuth != nil {
+		mux.Handle(middleware.DevJWKSPath, devAuth.JWKSHandler())
+	}
+	// Authing 登出时通知撤销对应的会话
+	if cfg.Auth.BackchannelLogout {
+		mux.Handle(middleware.BackchannelLogoutPath, authMiddleware.BackchannelLogoutHandler())
+	}
 	// Authing： 注入JWT中间件
-	http.Handle("/", playground.Handler("GraphQL", "/query"))
-	http.Handle("/query", authMiddleware.Middleware(srv))
-	log.Printf("🚀 Server started at http://localhost:%s/", port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+	mux.Handle("/query", authMiddleware.Middleware(tenantMiddleware.Middleware(resolver.LoaderMiddleware(srv))))
+
+	// 管理接口（expvar 指标等）只在单独配置的地址上提供，不暴露在对外的 API 端口
+	if cfg.Server.AdminAddr != "" {
+		adminListener, err := net.Listen("tcp", cfg.Server.AdminAddr)
+		if err != nil {
+			fatal("failed to listen on admin address", "addr", cfg.Server.AdminAddr, "error", err)
+		}
+		runWorker(func(ctx context.Context) {
+			if err := serveAdmin(ctx, adminListener); err != nil {
+				slog.Error("admin server stopped with error", "error", err)
+			}
+		})
+	}
+
+	// 请求日志在最外层，健康检查只在 debug 级别记录
+	httpHandler := middleware.Logging(slog.Default(), "/healthz", "/readyz")(mux)
+	serveErr := serve(ctx, cfg.Server, httpHandler, checker)
+	stopWorkers()
+	workers.Wait()
+	if serveErr != nil {
+		fatal("server stopped with error", "error", serveErr)
+	}
+	// 正常退出时由上面的 defer 关闭连接池
 }
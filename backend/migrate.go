@@ -0,0 +1,207 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/shiqi/datai/backend/internal/config"
+	"github.com/shiqi/datai/backend/internal/migration"
+)
+
+const migrateUsage = `用法: datai migrate <command> [--db=<name>]
+
+命令:
+  up [N]        应用全部（或 N 个）未执行的迁移，未指定 --db 时作用于全部数据库
+  down N        回滚最近的 N 个迁移，需要 --db
+  steps N       正数向上应用 N 个迁移，负数回滚，需要 --db
+  status        查看当前版本、最新版本和 dirty 状态
+  force V       把版本记录设为 V 并清除 dirty 标记（不执行迁移），需要 --db
+  create NAME   创建下一个序号的空迁移文件，需要 --db
+
+数据库: user, events, tenant, log`
+
+// migrationDatabases 需要管理迁移的全部数据库，数据库 user 对应迁移目录 migrations/user
+func migrationDatabases(cfg *config.Config) []migration.Database {
+	result := make([]migration.Database, 0, len(config.Databases))
+	for _, name := range config.Databases {
+		result = append(result, migration.Database{
+			Name: name,
+			DSN:  cfg.Database.DSN(name),
+			Dir:  filepath.Join("migrations", name),
+		})
+	}
+	return result
+}
+
+// runMigrateCommand 执行 migrate 子命令，返回进程退出码
+func runMigrateCommand(cfg *config.Config, args []string) int {
+	positional, dbName, err := parseMigrateArgs(args)
+	if err != nil || len(positional) == 0 {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	targets, err := selectDatabases(cfg, dbName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	if err := migrateCommand(positional[0], positional[1:], dbName, targets); err != nil {
+		fmt.Fprintln(os.Stderr, "migrate:", err)
+		return 1
+	}
+	return 0
+}
+
+func migrateCommand(cmd string, args []string, dbName string, targets []migration.Database) error {
+	// 会改变版本的单库操作必须显式指定数据库
+	switch cmd {
+	case "down", "steps", "force", "create":
+		if dbName == "" {
+			return fmt.Errorf("%s requires --db", cmd)
+		}
+	}
+
+	switch cmd {
+	case "up":
+		n := 0
+		if len(args) > 0 {
+			var err error
+			if n, err = positiveArg(args[0]); err != nil {
+				return err
+			}
+		}
+		for _, db := range targets {
+			var err error
+			if n > 0 {
+				err = migration.Steps(db, n)
+			} else {
+				err = migration.Up(db)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s: up to date\n", db.Name)
+		}
+		return nil
+
+	case "down":
+		if len(args) == 0 {
+			return errors.New("down requires the number of migrations to roll back")
+		}
+		n, err := positiveArg(args[0])
+		if err != nil {
+			return err
+		}
+		return migration.Down(targets[0], n)
+
+	case "steps":
+		if len(args) == 0 {
+			return errors.New("steps requires N")
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid steps %q", args[0])
+		}
+		return migration.Steps(targets[0], n)
+
+	case "status":
+		for _, db := range targets {
+			status, err := migration.GetStatus(db)
+			if err != nil {
+				return err
+			}
+			state := "clean"
+			if status.Dirty {
+				state = "dirty"
+			}
+			fmt.Printf("%-8s version=%d latest=%d %s\n", db.Name, status.Version, status.Latest, state)
+		}
+		return nil
+
+	case "force":
+		if len(args) == 0 {
+			return errors.New("force requires a version")
+		}
+		v, err := strconv.Atoi(args[0])
+		if err != nil || v < -1 {
+			return fmt.Errorf("invalid version %q", args[0])
+		}
+		return migration.Force(targets[0], v)
+
+	case "create":
+		if len(args) == 0 {
+			return errors.New("create requires a name")
+		}
+		up, down, err := migration.Create(targets[0].Dir, strings.Join(args, "_"))
+		if err != nil {
+			return err
+		}
+		fmt.Println(up)
+		fmt.Println(down)
+		return nil
+	}
+	return fmt.Errorf("unknown command %q", cmd)
+}
+
+// parseMigrateArgs 分离位置参数和 --db；flag 可以出现在任意位置，负数（steps -1）按位置参数处理
+func parseMigrateArgs(args []string) (positional []string, dbName string, err error) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		switch {
+		case !strings.HasPrefix(arg, "-") || isInteger(arg):
+			positional = append(positional, arg)
+		case name == "db":
+			if !hasValue {
+				if i+1 >= len(args) {
+					return nil, "", errors.New("--db requires a value")
+				}
+				i++
+				value = args[i]
+			}
+			dbName = value
+		case name == "h" || name == "help":
+			return nil, "", nil
+		default:
+			return nil, "", fmt.Errorf("unknown flag %s", arg)
+		}
+	}
+	return positional, dbName, nil
+}
+
+// selectDatabases 按 --db 选择数据库，为空时返回全部；接受 events 或 events_db
+func selectDatabases(cfg *config.Config, dbName string) ([]migration.Database, error) {
+	all := migrationDatabases(cfg)
+	if dbName == "" {
+		return all, nil
+	}
+	name := strings.TrimSuffix(dbName, "_db")
+	for _, db := range all {
+		if db.Name == name {
+			return []migration.Database{db}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown database %q", dbName)
+}
+
+func positiveArg(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return n, nil
+}
+
+func isInteger(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
@@ -0,0 +1,9 @@
+-- Migration 008: Drop cancellation columns from events table
+
+-- Drop triggers first
+DROP TRIGGER IF EXISTS update_events_updated_at ON events;
+
+-- Drop columns
+ALTER TABLE events DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE events DROP COLUMN IF EXISTS cancel_reason;
+ALTER TABLE events DROP COLUMN IF EXISTS cancelled_at;
@@ -0,0 +1,9 @@
+-- Migration 008: Add cancellation columns to events table
+
+ALTER TABLE events ADD COLUMN cancelled_at TIMESTAMPTZ;
+ALTER TABLE events ADD COLUMN cancel_reason TEXT;
+ALTER TABLE events ADD COLUMN updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP;
+
+-- Create trigger to automatically update updated_at
+CREATE TRIGGER update_events_updated_at BEFORE UPDATE ON events
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
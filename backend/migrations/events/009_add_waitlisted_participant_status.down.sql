@@ -0,0 +1,11 @@
+-- Migration 009: Remove waitlisted status from event_participants
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_event_participants_event_status_joined;
+
+-- Waitlisted rows fall back to pending so the original constraint holds
+UPDATE event_participants SET status = 'pending' WHERE status = 'waitlisted';
+
+ALTER TABLE event_participants DROP CONSTRAINT IF EXISTS event_participants_status_check;
+ALTER TABLE event_participants ADD CONSTRAINT event_participants_status_check
+    CHECK (status IN ('pending', 'approved', 'rejected', 'attended', 'no_show'));
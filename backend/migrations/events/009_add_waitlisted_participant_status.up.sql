@@ -0,0 +1,9 @@
+-- Migration 009: Allow waitlisted status on event_participants
+-- Joiners beyond participant_limit are queued and promoted when a seat frees up
+
+ALTER TABLE event_participants DROP CONSTRAINT IF EXISTS event_participants_status_check;
+ALTER TABLE event_participants ADD CONSTRAINT event_participants_status_check
+    CHECK (status IN ('pending', 'approved', 'rejected', 'waitlisted', 'attended', 'no_show'));
+
+-- Create index for waitlist promotion order
+CREATE INDEX idx_event_participants_event_status_joined ON event_participants(event_id, status, joined_at);
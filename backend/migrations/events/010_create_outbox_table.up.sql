@@ -0,0 +1,16 @@
+-- Migration 010: Create outbox table for cross-database side effects
+-- 与业务写入在同一事务中插入，由后台 relay 投递到其他数据库，失败按退避重试
+
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMPTZ,
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for relay polling
+CREATE INDEX idx_outbox_pending ON outbox(available_at, id) WHERE processed_at IS NULL;
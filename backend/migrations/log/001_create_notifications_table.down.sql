@@ -0,0 +1,8 @@
+-- Migration 001: Drop notifications table for PostgreSQL
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_notifications_user_unread;
+DROP INDEX IF EXISTS idx_notifications_user_id;
+
+-- Drop table
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,17 @@
+-- Migration 001: Create notifications table for PostgreSQL
+-- Based on DBML design for Datai project
+
+CREATE TABLE notifications (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    content TEXT NOT NULL,
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    channel_type VARCHAR(20) NOT NULL DEFAULT 'in_app' CHECK (channel_type IN ('in_app', 'email', 'sms', 'push')),
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, id DESC);
+CREATE INDEX idx_notifications_user_unread ON notifications(user_id) WHERE is_read = FALSE;
@@ -0,0 +1,3 @@
+-- Migration 002: Remove dedup_key from notifications
+
+ALTER TABLE notifications DROP COLUMN IF EXISTS dedup_key;
@@ -0,0 +1,4 @@
+-- Migration 002: Add dedup_key to notifications
+-- outbox 等至少投递一次的来源用它保证同一条通知只写入一次
+
+ALTER TABLE notifications ADD COLUMN dedup_key VARCHAR(100) UNIQUE;
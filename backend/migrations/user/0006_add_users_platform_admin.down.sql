@@ -0,0 +1,7 @@
+-- Migration 0006: Drop platform admin flag from users table
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_users_platform_admin;
+
+-- Drop columns
+ALTER TABLE users DROP COLUMN IF EXISTS is_platform_admin;
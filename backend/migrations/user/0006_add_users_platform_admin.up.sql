@@ -0,0 +1,6 @@
+-- Migration 0006: Add platform admin flag to users table
+
+ALTER TABLE users ADD COLUMN is_platform_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create partial index, platform admins are few
+CREATE INDEX idx_users_platform_admin ON users(id) WHERE is_platform_admin;
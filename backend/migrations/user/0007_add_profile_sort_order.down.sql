@@ -0,0 +1,9 @@
+-- Migration 0007: Drop sort_order from user_educations and user_employments tables
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_user_employments_user_sort;
+DROP INDEX IF EXISTS idx_user_educations_user_sort;
+
+-- Drop columns
+ALTER TABLE user_employments DROP COLUMN IF EXISTS sort_order;
+ALTER TABLE user_educations DROP COLUMN IF EXISTS sort_order;
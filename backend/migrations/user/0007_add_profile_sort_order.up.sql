@@ -0,0 +1,8 @@
+-- Migration 0007: Add sort_order to user_educations and user_employments tables
+
+ALTER TABLE user_educations ADD COLUMN sort_order INT NOT NULL DEFAULT 0;
+ALTER TABLE user_employments ADD COLUMN sort_order INT NOT NULL DEFAULT 0;
+
+-- Create indexes for better performance
+CREATE INDEX idx_user_educations_user_sort ON user_educations(user_id, sort_order);
+CREATE INDEX idx_user_employments_user_sort ON user_employments(user_id, sort_order);
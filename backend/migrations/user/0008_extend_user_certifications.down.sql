@@ -0,0 +1,16 @@
+-- Migration 0008: Drop profile links and review columns from user_certifications
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_user_certifications_employment_id;
+DROP INDEX IF EXISTS idx_user_certifications_education_id;
+DROP INDEX IF EXISTS idx_user_certifications_pending;
+
+-- Drop constraints
+ALTER TABLE user_certifications DROP CONSTRAINT IF EXISTS user_certifications_entry_check;
+
+-- Drop columns
+ALTER TABLE user_certifications DROP COLUMN IF EXISTS legacy_unlinked;
+ALTER TABLE user_certifications DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE user_certifications DROP COLUMN IF EXISTS review_reason;
+ALTER TABLE user_certifications DROP COLUMN IF EXISTS employment_id;
+ALTER TABLE user_certifications DROP COLUMN IF EXISTS education_id;
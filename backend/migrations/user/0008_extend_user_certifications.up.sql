@@ -0,0 +1,37 @@
+-- Migration 0008: Link user_certifications to profile entries and record review results
+
+ALTER TABLE user_certifications ADD COLUMN education_id BIGINT REFERENCES user_educations(id) ON DELETE CASCADE;
+ALTER TABLE user_certifications ADD COLUMN employment_id BIGINT REFERENCES user_employments(id) ON DELETE CASCADE;
+ALTER TABLE user_certifications ADD COLUMN review_reason TEXT;
+ALTER TABLE user_certifications ADD COLUMN reviewed_at TIMESTAMPTZ;
+
+-- 已有的 education / employment 认证没有关联履历：用户只有一条对应履历时关联到这条，
+-- 其余的保留原样并标记为 legacy_unlinked，不受关联检查约束，由用户在新流程中针对具体履历重新提交
+ALTER TABLE user_certifications ADD COLUMN legacy_unlinked BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE user_certifications c
+SET education_id = (SELECT e.id FROM user_educations e WHERE e.user_id = c.user_id)
+WHERE c.cert_type = 'education'
+  AND (SELECT COUNT(*) FROM user_educations e WHERE e.user_id = c.user_id) = 1;
+
+UPDATE user_certifications c
+SET employment_id = (SELECT e.id FROM user_employments e WHERE e.user_id = c.user_id)
+WHERE c.cert_type = 'employment'
+  AND (SELECT COUNT(*) FROM user_employments e WHERE e.user_id = c.user_id) = 1;
+
+UPDATE user_certifications
+SET legacy_unlinked = TRUE
+WHERE (cert_type = 'education' AND education_id IS NULL)
+   OR (cert_type = 'employment' AND employment_id IS NULL);
+
+-- education / employment 类型的认证必须关联对应的履历（迁移前遗留的未关联认证除外）
+ALTER TABLE user_certifications ADD CONSTRAINT user_certifications_entry_check CHECK (
+    legacy_unlinked
+    OR ((cert_type = 'education') = (education_id IS NOT NULL)
+        AND (cert_type = 'employment') = (employment_id IS NOT NULL))
+);
+
+-- Create indexes for better performance
+CREATE INDEX idx_user_certifications_pending ON user_certifications(id) WHERE status = 'pending';
+CREATE INDEX idx_user_certifications_education_id ON user_certifications(education_id);
+CREATE INDEX idx_user_certifications_employment_id ON user_certifications(employment_id);
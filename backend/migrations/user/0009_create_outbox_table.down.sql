@@ -0,0 +1,7 @@
+-- Migration 0009: Drop outbox table
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_outbox_pending;
+
+-- Drop table
+DROP TABLE IF EXISTS outbox;
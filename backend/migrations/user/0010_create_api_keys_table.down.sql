@@ -0,0 +1,7 @@
+-- Migration 0010: Drop api_keys table
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_api_keys_user_id;
+
+-- Drop table
+DROP TABLE IF EXISTS api_keys;
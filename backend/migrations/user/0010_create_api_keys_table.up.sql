@@ -0,0 +1,20 @@
+-- Migration 0010: Create api_keys table for server-to-server GraphQL access
+-- 只保存 key 的 SHA-256 摘要，明文只在创建时返回一次；prefix 用于查找 key 和在列表中辨认
+
+CREATE TABLE api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    tenant_id VARCHAR(64),
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(32) NOT NULL UNIQUE,
+    key_hash BYTEA NOT NULL,
+    scopes TEXT[] NOT NULL CHECK (cardinality(scopes) > 0 AND scopes <@ ARRAY['read', 'write']::TEXT[]),
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create index for listing a user's keys
+CREATE INDEX idx_api_keys_user_id ON api_keys(user_id);
@@ -0,0 +1,9 @@
+-- Migration 0011: Drop sessions and session_revocations tables
+
+DROP TABLE IF EXISTS session_revocations;
+
+-- Drop indexes first
+DROP INDEX IF EXISTS idx_sessions_user_uid;
+
+-- Drop table
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,25 @@
+-- Migration 0011: Create sessions and session_revocations tables for JWT revocation
+-- 一个会话对应签发方的 sid（token 没有 sid 时为 jti），认证中间件首次见到时登记
+-- session_revocations 记录用户"退出全部会话"的时间，早于该时间签发的 token 一律拒绝
+
+CREATE TABLE sessions (
+    id BIGSERIAL PRIMARY KEY,
+    issuer VARCHAR(255) NOT NULL,
+    session_key VARCHAR(255) NOT NULL,
+    user_uid VARCHAR(255) NOT NULL,
+    user_agent VARCHAR(512),
+    ip_address VARCHAR(64),
+    expires_at TIMESTAMPTZ,
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (issuer, session_key)
+);
+
+-- Create index for listing a user's sessions
+CREATE INDEX idx_sessions_user_uid ON sessions(user_uid);
+
+CREATE TABLE session_revocations (
+    user_uid VARCHAR(255) PRIMARY KEY,
+    revoked_before TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/shiqi/datai/backend/internal/config"
+	"github.com/shiqi/datai/backend/internal/health"
+	"github.com/shiqi/datai/backend/internal/middleware"
+)
+
+// waitForDB 等待数据库可连接，超过 timeout 或 ctx 被取消（例如收到 SIGTERM）时返回错误
+func waitForDB(ctx context.Context, dsn string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for {
+		pingCtx, cancelPing := context.WithTimeout(ctx, 5*time.Second)
+		err = db.PingContext(pingCtx)
+		cancelPing()
+		if err == nil {
+			slog.Info("connected to database", "dsn", config.RedactDSN(dsn))
+			return nil
+		}
+		slog.Info("waiting for database", "dsn", config.RedactDSN(dsn), "error", err)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database %s not ready after %s: %w", config.RedactDSN(dsn), timeout, err)
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// wsTracker 记录进行中的 WebSocket 连接，退出时等待它们关闭
+type wsTracker struct {
+	wg sync.WaitGroup
+}
+
+func (t *wsTracker) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !middleware.IsWebSocketUpgrade(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		// 订阅是长连接，不受 http.Server 读写超时限制，由 keepalive 检测断线
+		rc := http.NewResponseController(w)
+		_ = rc.SetReadDeadline(time.Time{})
+		_ = rc.SetWriteDeadline(time.Time{})
+
+		t.wg.Add(1)
+		defer t.wg.Done()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// serve 启动 HTTP 服务直到 ctx 被取消，然后优雅退出：
+// /readyz 返回 503 → 停止接收新连接并等待进行中的 HTTP 请求 → 关闭 WebSocket 订阅并等待连接退出
+func serve(ctx context.Context, cfg config.ServerConfig, handler http.Handler, checker *health.Checker) error {
+	// 请求的 ctx 都派生自 baseCtx；gqlgen 的 WebSocket 连接在它取消时发送 close 帧并退出
+	baseCtx, cancelBase := context.WithCancel(context.Background())
+	defer cancelBase()
+
+	var tracker wsTracker
+	srv := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.Port),
+		Handler:      tracker.Middleware(handler),
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+		BaseContext:  func(net.Listener) context.Context { return baseCtx },
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+	slog.Info("server started", "addr", srv.Addr)
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down, draining requests and subscriptions", "timeout", cfg.ShutdownTimeout)
+	checker.SetDraining()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	// Shutdown 不跟踪已升级的 WebSocket 连接，它们在 cancelBase 之后单独等待
+	err := srv.Shutdown(shutdownCtx)
+	cancelBase()
+
+	done := make(chan struct{})
+	go func() {
+		tracker.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-shutdownCtx.Done():
+		err = errors.Join(err, errors.New("websocket subscriptions did not close before shutdown timeout"))
+	}
+
+	if err != nil {
+		srv.Close()
+		return fmt.Errorf("graceful shutdown: %w", err)
+	}
+	slog.Info("server stopped")
+	return nil
+}
+
+// serveAdmin 在管理端口提供 /debug/vars（expvar 指标，包括 JWKS 缓存的命中、获取和失败次数），直到 ctx 被取消。
+// 管理端口没有认证，只应监听在内网或本机地址
+func serveAdmin(ctx context.Context, ln net.Listener) error {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	srv := &http.Server{
+		Handler:           middleware.Logging(slog.Default())(mux),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ln)
+	}()
+	slog.Info("admin server started", "addr", ln.Addr().String())
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return srv.Shutdown(shutdownCtx)
+}
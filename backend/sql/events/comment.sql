@@ -0,0 +1,40 @@
+-- name: GetCommentByID :one
+SELECT * FROM event_comments WHERE id = $1;
+
+-- name: CreateComment :one
+INSERT INTO event_comments (event_id, user_id, user_nickname, content, parent_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: DeleteComment :exec
+DELETE FROM event_comments WHERE id = $1;
+
+-- name: CountTopLevelComments :one
+SELECT COUNT(*) FROM event_comments
+WHERE event_id = $1 AND parent_id IS NULL;
+
+-- name: ListTopLevelComments :many
+SELECT * FROM event_comments
+WHERE event_id = sqlc.arg('event_id')
+  AND parent_id IS NULL
+  AND (
+    sqlc.narg('after_created_at')::timestamptz IS NULL
+    OR (created_at, id) < (sqlc.narg('after_created_at')::timestamptz, sqlc.narg('after_id')::bigint)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListCommentReplies :many
+WITH RECURSIVE tree AS (
+    SELECT c.id, c.event_id, c.user_id, c.user_nickname, c.content, c.parent_id, c.created_at, 1 AS depth
+    FROM event_comments c
+    WHERE c.parent_id = ANY(sqlc.arg('root_ids')::bigint[])
+    UNION ALL
+    SELECT c.id, c.event_id, c.user_id, c.user_nickname, c.content, c.parent_id, c.created_at, t.depth + 1
+    FROM event_comments c
+    JOIN tree t ON c.parent_id = t.id
+    WHERE t.depth < sqlc.arg('max_depth')::int
+)
+SELECT id, event_id, user_id, user_nickname, content, parent_id, created_at, depth
+FROM tree
+ORDER BY created_at ASC, id ASC;
@@ -0,0 +1,48 @@
+-- name: GetEventByID :one
+SELECT * FROM events WHERE id = $1;
+
+-- name: CreateEvent :one
+INSERT INTO events (
+    owner_id, owner_nickname, title, description, primary_tag, secondary_tags,
+    start_time, end_time, location_type, location_detail, cover_image,
+    require_approval, participant_limit, group_id, tenant_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+)
+RETURNING *;
+
+-- name: UpdateEvent :one
+UPDATE events
+SET title = $2,
+    description = $3,
+    primary_tag = $4,
+    secondary_tags = $5,
+    start_time = $6,
+    end_time = $7,
+    location_type = $8,
+    location_detail = $9,
+    cover_image = $10,
+    require_approval = $11,
+    participant_limit = $12
+WHERE id = $1
+RETURNING *;
+
+-- name: CancelEvent :one
+UPDATE events
+SET cancelled_at = NOW(),
+    cancel_reason = $2
+WHERE id = $1 AND cancelled_at IS NULL
+RETURNING *;
+
+-- name: ListEvents :many
+SELECT * FROM events
+WHERE (sqlc.narg('owner_id')::bigint IS NULL OR owner_id = sqlc.narg('owner_id'))
+  AND (sqlc.narg('group_id')::bigint IS NULL OR group_id = sqlc.narg('group_id'))
+  AND (sqlc.narg('primary_tag')::text IS NULL OR primary_tag = sqlc.narg('primary_tag'))
+  AND (sqlc.narg('location_type')::text IS NULL OR location_type = sqlc.narg('location_type'))
+  AND (sqlc.narg('starts_after')::timestamptz IS NULL OR start_time >= sqlc.narg('starts_after'))
+  AND (sqlc.narg('starts_before')::timestamptz IS NULL OR start_time < sqlc.narg('starts_before'))
+  AND (sqlc.arg('include_cancelled')::boolean OR cancelled_at IS NULL)
+  AND (tenant_id IS NULL OR tenant_id = ANY(sqlc.arg('tenant_ids')::text[]))
+ORDER BY start_time ASC, id ASC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
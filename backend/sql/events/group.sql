@@ -0,0 +1,71 @@
+-- name: GetGroupByID :one
+SELECT * FROM event_groups WHERE id = $1;
+
+-- name: CreateGroup :one
+INSERT INTO event_groups (name, owner_id, description, tags, pictures, is_public)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: UpdateGroup :one
+UPDATE event_groups
+SET name = $2,
+    description = $3,
+    tags = $4,
+    pictures = $5,
+    is_public = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: ListPublicGroups :many
+SELECT * FROM event_groups
+WHERE is_public = TRUE
+ORDER BY score_avg DESC, id DESC
+LIMIT $1 OFFSET $2;
+
+-- name: SetEventGroup :one
+UPDATE events
+SET group_id = sqlc.narg('group_id')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: LockGroup :one
+SELECT id FROM event_groups WHERE id = $1 FOR UPDATE;
+
+-- name: RefreshGroupCounters :exec
+UPDATE event_groups
+SET event_count = (
+        SELECT COUNT(*) FROM events e
+        WHERE e.group_id = sqlc.arg('group_id')::bigint AND e.cancelled_at IS NULL
+    ),
+    total_participants = (
+        SELECT COUNT(*) FROM event_participants p
+        JOIN events e ON e.id = p.event_id
+        WHERE e.group_id = sqlc.arg('group_id')::bigint
+          AND e.cancelled_at IS NULL
+          AND p.status IN ('approved', 'attended')
+          AND p.left_at IS NULL
+    )
+WHERE event_groups.id = sqlc.arg('group_id')::bigint;
+
+-- name: SubscribeGroup :exec
+INSERT INTO event_group_subscriptions (user_id, group_id)
+VALUES ($1, $2)
+ON CONFLICT (user_id, group_id) DO NOTHING;
+
+-- name: UnsubscribeGroup :exec
+DELETE FROM event_group_subscriptions WHERE user_id = $1 AND group_id = $2;
+
+-- name: IsSubscribedToGroup :one
+SELECT EXISTS (
+    SELECT 1 FROM event_group_subscriptions WHERE user_id = $1 AND group_id = $2
+);
+
+-- name: ListSubscribedGroupEvents :many
+SELECT e.* FROM events e
+JOIN event_group_subscriptions s ON s.group_id = e.group_id
+WHERE s.user_id = sqlc.arg('user_id')
+  AND e.start_time > NOW()
+  AND e.cancelled_at IS NULL
+  AND (e.tenant_id IS NULL OR e.tenant_id = ANY(sqlc.arg('tenant_ids')::text[]))
+ORDER BY e.start_time ASC, e.id ASC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
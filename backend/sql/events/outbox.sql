@@ -0,0 +1,31 @@
+-- name: EnqueueOutbox :exec
+INSERT INTO outbox (kind, payload)
+VALUES ($1, $2);
+
+-- name: ClaimOutbox :many
+-- 认领到期的消息并顺延 available_at 作为租约，租约内未完成的消息会被重新认领
+UPDATE outbox
+SET attempts = attempts + 1,
+    available_at = NOW() + sqlc.arg('lease_seconds')::int * INTERVAL '1 second'
+WHERE id IN (
+    SELECT id FROM outbox
+    WHERE processed_at IS NULL
+      AND available_at <= NOW()
+      AND attempts < sqlc.arg('max_attempts')::int
+    ORDER BY id
+    LIMIT sqlc.arg('batch_size')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompleteOutbox :exec
+UPDATE outbox
+SET processed_at = NOW(),
+    last_error = NULL
+WHERE id = $1;
+
+-- name: RetryOutbox :exec
+UPDATE outbox
+SET last_error = sqlc.arg('last_error'),
+    available_at = NOW() + sqlc.arg('delay_seconds')::int * INTERVAL '1 second'
+WHERE id = sqlc.arg('id');
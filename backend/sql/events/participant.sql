@@ -0,0 +1,53 @@
+-- name: GetEventForUpdate :one
+SELECT * FROM events WHERE id = $1 FOR UPDATE;
+
+-- name: GetParticipant :one
+SELECT * FROM event_participants WHERE event_id = $1 AND user_id = $2;
+
+-- name: CountActiveParticipants :one
+SELECT COUNT(*) FROM event_participants
+WHERE event_id = $1
+  AND status IN ('approved', 'attended')
+  AND left_at IS NULL;
+
+-- name: UpsertParticipant :one
+INSERT INTO event_participants (event_id, user_id, status, joined_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (event_id, user_id) DO UPDATE
+SET status = EXCLUDED.status,
+    joined_at = NOW(),
+    left_at = NULL
+RETURNING *;
+
+-- name: UpdateParticipantStatus :one
+UPDATE event_participants
+SET status = $3
+WHERE event_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: MarkParticipantLeft :one
+UPDATE event_participants
+SET left_at = NOW()
+WHERE event_id = $1 AND user_id = $2 AND left_at IS NULL
+RETURNING *;
+
+-- name: GetNextWaitlistedParticipant :one
+SELECT * FROM event_participants
+WHERE event_id = $1
+  AND status = 'waitlisted'
+  AND left_at IS NULL
+ORDER BY joined_at ASC, id ASC
+LIMIT 1
+FOR UPDATE;
+
+-- name: ListParticipants :many
+SELECT * FROM event_participants
+WHERE event_id = sqlc.arg('event_id')
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND left_at IS NULL
+ORDER BY joined_at ASC, id ASC;
+
+-- name: ListAttendedParticipants :many
+SELECT * FROM event_participants
+WHERE event_id = $1 AND status = 'attended'
+ORDER BY joined_at ASC, id ASC;
@@ -0,0 +1,44 @@
+-- name: UpsertEventRating :one
+INSERT INTO event_ratings (event_id, rater_id, score, comment)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (event_id, rater_id) DO UPDATE
+SET score = EXCLUDED.score,
+    comment = EXCLUDED.comment,
+    created_at = NOW()
+RETURNING *;
+
+-- name: UpsertUserRating :one
+INSERT INTO user_ratings (event_id, rater_id, target_user_id, score, comment)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (event_id, rater_id, target_user_id) DO UPDATE
+SET score = EXCLUDED.score,
+    comment = EXCLUDED.comment,
+    created_at = NOW()
+RETURNING *;
+
+-- name: GetUserRatingStats :one
+SELECT COALESCE(ROUND(AVG(score), 2), 0)::numeric(3,2) AS rating_avg,
+       COUNT(*)::int AS rating_count
+FROM user_ratings
+WHERE target_user_id = $1;
+
+-- name: RecomputeGroupScore :exec
+-- 系列评分 = 各场活动平均分按到场人数加权
+WITH per_event AS (
+    SELECT e.id,
+           AVG(r.score) AS avg_score,
+           COUNT(r.id) AS ratings,
+           GREATEST((
+               SELECT COUNT(*) FROM event_participants p
+               WHERE p.event_id = e.id AND p.status = 'attended'
+           ), 1) AS weight
+    FROM events e
+    JOIN event_ratings r ON r.event_id = e.id
+    WHERE e.group_id = sqlc.arg('group_id')::bigint
+    GROUP BY e.id
+)
+UPDATE event_groups
+SET score_avg = COALESCE((SELECT ROUND(SUM(avg_score * weight) / SUM(weight), 2) FROM per_event), 0),
+    rating_count = COALESCE((SELECT SUM(ratings) FROM per_event), 0),
+    score_updated_at = NOW()
+WHERE event_groups.id = sqlc.arg('group_id')::bigint;
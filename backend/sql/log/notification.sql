@@ -0,0 +1,40 @@
+-- name: CreateNotification :one
+-- dedup_key 已存在时不插入，返回 no rows
+INSERT INTO notifications (user_id, type, content, channel_type, dedup_key, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (dedup_key) DO NOTHING
+RETURNING *;
+
+-- name: GetNotificationByDedupKey :one
+SELECT * FROM notifications
+WHERE dedup_key = $1;
+
+-- name: GetNotification :one
+SELECT * FROM notifications
+WHERE id = $1 AND user_id = $2;
+
+-- name: ListNotifications :many
+-- 按 id 倒序分页，after_id 为上一页最后一条的 id
+SELECT * FROM notifications
+WHERE user_id = sqlc.arg('user_id')
+  AND (NOT sqlc.arg('unread_only')::boolean OR is_read = FALSE)
+  AND (sqlc.narg('after_id')::bigint IS NULL OR id < sqlc.narg('after_id'))
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications
+WHERE user_id = $1 AND is_read = FALSE;
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+SET is_read = TRUE,
+    read_at = COALESCE(read_at, NOW())
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET is_read = TRUE,
+    read_at = NOW()
+WHERE user_id = $1 AND is_read = FALSE;
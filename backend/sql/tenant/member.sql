@@ -0,0 +1,40 @@
+-- name: GetTenantMember :one
+SELECT * FROM user_tenants
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: AddTenantMember :one
+INSERT INTO user_tenants (user_id, tenant_id, role, joined_at)
+VALUES ($1, $2, $3, NOW())
+RETURNING *;
+
+-- name: UpdateTenantMemberRole :one
+UPDATE user_tenants
+SET role = $3
+WHERE tenant_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: RemoveTenantMember :exec
+DELETE FROM user_tenants
+WHERE tenant_id = $1 AND user_id = $2;
+
+-- name: CountTenantMembersByRole :one
+SELECT COUNT(*) FROM user_tenants
+WHERE tenant_id = $1 AND role = $2;
+
+-- name: LockTenant :exec
+SELECT id FROM tenants WHERE id = $1 FOR UPDATE;
+
+-- name: ListTenantMembers :many
+SELECT * FROM user_tenants
+WHERE tenant_id = $1
+ORDER BY joined_at ASC, user_id ASC;
+
+-- name: ListUserTenants :many
+SELECT t.id, t.name, t.region, t.created_at, ut.role, ut.joined_at
+FROM user_tenants ut
+JOIN tenants t ON t.id = ut.tenant_id
+WHERE ut.user_id = $1
+ORDER BY ut.joined_at ASC;
+
+-- name: ListUserTenantIDs :many
+SELECT tenant_id FROM user_tenants WHERE user_id = $1;
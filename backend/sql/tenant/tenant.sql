@@ -0,0 +1,7 @@
+-- name: GetTenantByID :one
+SELECT * FROM tenants WHERE id = $1;
+
+-- name: CreateTenant :one
+INSERT INTO tenants (id, name, region)
+VALUES ($1, $2, $3)
+RETURNING *;
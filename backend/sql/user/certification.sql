@@ -0,0 +1,45 @@
+-- name: CreateCertification :one
+INSERT INTO user_certifications (user_id, cert_type, status, proof, education_id, employment_id, created_at)
+VALUES ($1, $2, 'pending', $3, $4, $5, NOW())
+RETURNING *;
+
+-- name: GetCertificationForUpdate :one
+SELECT * FROM user_certifications WHERE id = $1 FOR UPDATE;
+
+-- name: CountPendingCertificationsForEntry :one
+SELECT COUNT(*) FROM user_certifications
+WHERE status = 'pending'
+  AND user_id = sqlc.arg('user_id')
+  AND cert_type = sqlc.arg('cert_type')
+  AND education_id IS NOT DISTINCT FROM sqlc.narg('education_id')
+  AND employment_id IS NOT DISTINCT FROM sqlc.narg('employment_id');
+
+-- name: ListPendingCertifications :many
+-- 审核队列，先提交先审核
+SELECT * FROM user_certifications
+WHERE status = 'pending'
+  AND (sqlc.narg('after_id')::bigint IS NULL OR id > sqlc.narg('after_id'))
+ORDER BY id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListUserCertifications :many
+SELECT * FROM user_certifications
+WHERE user_id = $1
+ORDER BY id DESC;
+
+-- name: ReviewCertification :one
+UPDATE user_certifications
+SET status = $2,
+    reviewer_id = $3,
+    review_reason = $4,
+    reviewed_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: SetEducationVerified :exec
+UPDATE user_educations SET is_verified = TRUE
+WHERE id = $1 AND user_id = $2;
+
+-- name: SetEmploymentVerified :exec
+UPDATE user_employments SET is_verified = TRUE
+WHERE id = $1 AND user_id = $2;
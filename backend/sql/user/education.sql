@@ -0,0 +1,38 @@
+-- name: GetEducationByID :one
+SELECT * FROM user_educations WHERE id = $1;
+
+-- name: ListEducationsByUserIDs :many
+SELECT * FROM user_educations
+WHERE user_id = ANY(sqlc.arg('user_ids')::bigint[])
+ORDER BY user_id, sort_order ASC, id ASC;
+
+-- name: CreateEducation :one
+INSERT INTO user_educations (user_id, school_name, degree, major, sort_order)
+VALUES (
+    $1, $2, $3, $4,
+    (SELECT COALESCE(MAX(sort_order) + 1, 0) FROM user_educations WHERE user_id = $1)
+)
+RETURNING *;
+
+-- name: UpdateEducation :one
+-- 内容有变化时认证失效，is_verified 只能由认证审核置为 TRUE
+UPDATE user_educations
+SET school_name = sqlc.arg('school_name'),
+    degree = sqlc.narg('degree'),
+    major = sqlc.narg('major'),
+    is_verified = COALESCE(is_verified, FALSE)
+        AND school_name = sqlc.arg('school_name')
+        AND degree IS NOT DISTINCT FROM sqlc.narg('degree')
+        AND major IS NOT DISTINCT FROM sqlc.narg('major')
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
+RETURNING *;
+
+-- name: DeleteEducation :execrows
+DELETE FROM user_educations
+WHERE id = $1 AND user_id = $2;
+
+-- name: ReorderEducations :exec
+-- ids 中的位置即新的 sort_order
+UPDATE user_educations
+SET sort_order = array_position(sqlc.arg('ids')::bigint[], id) - 1
+WHERE user_id = sqlc.arg('user_id') AND id = ANY(sqlc.arg('ids')::bigint[]);
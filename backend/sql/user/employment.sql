@@ -0,0 +1,36 @@
+-- name: GetEmploymentByID :one
+SELECT * FROM user_employments WHERE id = $1;
+
+-- name: ListEmploymentsByUserIDs :many
+SELECT * FROM user_employments
+WHERE user_id = ANY(sqlc.arg('user_ids')::bigint[])
+ORDER BY user_id, sort_order ASC, id ASC;
+
+-- name: CreateEmployment :one
+INSERT INTO user_employments (user_id, company_name, job_title, sort_order)
+VALUES (
+    $1, $2, $3,
+    (SELECT COALESCE(MAX(sort_order) + 1, 0) FROM user_employments WHERE user_id = $1)
+)
+RETURNING *;
+
+-- name: UpdateEmployment :one
+-- 内容有变化时认证失效，is_verified 只能由认证审核置为 TRUE
+UPDATE user_employments
+SET company_name = sqlc.arg('company_name'),
+    job_title = sqlc.narg('job_title'),
+    is_verified = COALESCE(is_verified, FALSE)
+        AND company_name = sqlc.arg('company_name')
+        AND job_title IS NOT DISTINCT FROM sqlc.narg('job_title')
+WHERE id = sqlc.arg('id') AND user_id = sqlc.arg('user_id')
+RETURNING *;
+
+-- name: DeleteEmployment :execrows
+DELETE FROM user_employments
+WHERE id = $1 AND user_id = $2;
+
+-- name: ReorderEmployments :exec
+-- ids 中的位置即新的 sort_order
+UPDATE user_employments
+SET sort_order = array_position(sqlc.arg('ids')::bigint[], id) - 1
+WHERE user_id = sqlc.arg('user_id') AND id = ANY(sqlc.arg('ids')::bigint[]);
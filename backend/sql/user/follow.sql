@@ -0,0 +1,62 @@
+-- name: FollowUser :exec
+INSERT INTO user_follows (follower_id, followee_id, created_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (follower_id, followee_id) DO NOTHING;
+
+-- name: UnfollowUser :exec
+DELETE FROM user_follows
+WHERE follower_id = $1 AND followee_id = $2;
+
+-- name: ListFollowersByUserIDs :many
+-- 关注 user_ids 中每个用户的粉丝，每个用户最多 limit 条，供 DataLoader 批量分页
+-- 按关注时间倒序，游标为 (followed_at, id)，同一批查询的用户共用同一游标
+SELECT sqlc.embed(u), ranked.followee_id AS user_id, ranked.followed_at
+FROM (
+  SELECT f.follower_id, f.followee_id, f.created_at AS followed_at,
+         ROW_NUMBER() OVER (PARTITION BY f.followee_id ORDER BY f.created_at DESC, f.follower_id DESC) AS rn
+  FROM user_follows f
+  WHERE f.followee_id = ANY(sqlc.arg('user_ids')::bigint[])
+    AND (
+      sqlc.narg('after_followed_at')::timestamptz IS NULL
+      OR (f.created_at, f.follower_id) < (sqlc.narg('after_followed_at')::timestamptz, sqlc.narg('after_id')::bigint)
+    )
+) ranked
+JOIN users u ON u.id = ranked.follower_id
+WHERE ranked.rn <= sqlc.arg('limit')::int
+ORDER BY ranked.followee_id, ranked.rn;
+
+-- name: ListFollowingByUserIDs :many
+-- user_ids 中每个用户关注的用户，排序和游标同 ListFollowersByUserIDs
+SELECT sqlc.embed(u), ranked.follower_id AS user_id, ranked.followed_at
+FROM (
+  SELECT f.follower_id, f.followee_id, f.created_at AS followed_at,
+         ROW_NUMBER() OVER (PARTITION BY f.follower_id ORDER BY f.created_at DESC, f.followee_id DESC) AS rn
+  FROM user_follows f
+  WHERE f.follower_id = ANY(sqlc.arg('user_ids')::bigint[])
+    AND (
+      sqlc.narg('after_followed_at')::timestamptz IS NULL
+      OR (f.created_at, f.followee_id) < (sqlc.narg('after_followed_at')::timestamptz, sqlc.narg('after_id')::bigint)
+    )
+) ranked
+JOIN users u ON u.id = ranked.followee_id
+WHERE ranked.rn <= sqlc.arg('limit')::int
+ORDER BY ranked.follower_id, ranked.rn;
+
+-- name: CountFollowersByUserIDs :many
+SELECT followee_id AS user_id, COUNT(*)::int AS count
+FROM user_follows
+WHERE followee_id = ANY(sqlc.arg('user_ids')::bigint[])
+GROUP BY followee_id;
+
+-- name: CountFollowingByUserIDs :many
+SELECT follower_id AS user_id, COUNT(*)::int AS count
+FROM user_follows
+WHERE follower_id = ANY(sqlc.arg('user_ids')::bigint[])
+GROUP BY follower_id;
+
+-- name: ListFollowPairs :many
+-- 批量判断关注关系，返回 follower_ids × followee_ids 中实际存在的关注
+SELECT follower_id, followee_id
+FROM user_follows
+WHERE follower_id = ANY(sqlc.arg('follower_ids')::bigint[])
+  AND followee_id = ANY(sqlc.arg('followee_ids')::bigint[]);
@@ -0,0 +1,53 @@
+-- name: TouchSession :one
+-- 登记会话；已存在时更新最近访问时间和来源，刷新后的 token 会延长过期时间
+INSERT INTO sessions (issuer, session_key, user_uid, user_agent, ip_address, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (issuer, session_key) DO UPDATE
+SET last_seen_at = NOW(),
+    user_agent = EXCLUDED.user_agent,
+    ip_address = EXCLUDED.ip_address,
+    expires_at = GREATEST(sessions.expires_at, EXCLUDED.expires_at)
+RETURNING *;
+
+-- name: ListActiveSessionsByUser :many
+SELECT * FROM sessions
+WHERE user_uid = $1
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY last_seen_at DESC;
+
+-- name: RevokeSession :one
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE id = $1 AND user_uid = $2 AND revoked_at IS NULL
+RETURNING *;
+
+-- name: RevokeSessionsByUser :execrows
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE user_uid = $1 AND revoked_at IS NULL;
+
+-- name: RevokeSessionByKey :exec
+-- back-channel logout 可能早于该会话的第一个请求到达，此时直接登记为已撤销
+INSERT INTO sessions (issuer, session_key, user_uid, revoked_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (issuer, session_key) DO UPDATE
+SET revoked_at = COALESCE(sessions.revoked_at, NOW());
+
+-- name: GetSessionByKey :one
+SELECT * FROM sessions WHERE issuer = $1 AND session_key = $2;
+
+-- name: GetSessionCutoff :one
+SELECT revoked_before FROM session_revocations WHERE user_uid = $1;
+
+-- name: SetSessionCutoff :exec
+INSERT INTO session_revocations (user_uid, revoked_before)
+VALUES ($1, NOW())
+ON CONFLICT (user_uid) DO UPDATE
+SET revoked_before = NOW();
+
+-- name: DeleteExpiredSessions :execrows
+-- 没有过期时间的会话按最近访问时间清理
+DELETE FROM sessions
+WHERE expires_at < $1
+   OR (expires_at IS NULL AND last_seen_at < $1);
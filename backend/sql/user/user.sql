@@ -10,3 +10,9 @@ VALUES ($1, $2, NOW(), NOW())
 ON CONFLICT (uid) DO UPDATE
 SET nickname = EXCLUDED.nickname,
     updated_at = NOW();
+
+-- name: UpdateUserRating :exec
+UPDATE users
+SET rating_avg = $2,
+    rating_count = $3
+WHERE id = $1;